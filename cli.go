@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"learn/fiber/pkg/migration"
+)
+
+const usage = `usage: fiber <command> [arguments]
+
+commands:
+  serve                      run the API server, the default command
+  migrate up                 apply every pending migration
+  migrate down [steps]       roll back the latest migrations, 1 by default
+  migrate status             list migrations and when they were applied
+  migrate create <name>      write an empty up and down migration to ` + migration.Dir + `
+  seed <file>                load users and blogs from a .yaml, .yml or .json fixture
+  import wordpress <file>    import a WordPress export, see import wordpress -h
+  user create-admin          create an admin, see user create-admin -h
+  user reset-password        set a new password, see user reset-password -h
+  routes                     print the routes with their middleware
+  config check               validate the environment and the database connection
+
+user commands read the password from stdin when -password is not given.`
+
+// run dispatches the command line to a command, serve when there is none so
+// the server still starts without arguments.
+func run(args []string) error {
+	if len(args) == 0 {
+		return runServe(nil)
+	}
+
+	switch args[0] {
+	case "serve":
+		return runServe(args[1:])
+	case "migrate":
+		return runMigrate(args[1:])
+	case "seed":
+		return runSeed(args[1:])
+	case "import":
+		return runImport(args[1:])
+	case "user":
+		return runUser(args[1:])
+	case "routes":
+		return runRoutes(args[1:])
+	case "config":
+		return runConfig(args[1:])
+	case "help", "-h", "--help":
+		fmt.Println(usage)
+		return nil
+	default:
+		return usageError(fmt.Sprintf("unknown command %q", args[0]))
+	}
+}
+
+// usageError reports a misused command together with the usage.
+func usageError(message string) error {
+	return fmt.Errorf("%s\n\n%s", message, usage)
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"learn/fiber/pkg/model/entity"
+	"strconv"
 
 	"github.com/gofiber/fiber/v2/log"
 	"gorm.io/driver/postgres"
@@ -10,6 +11,18 @@ import (
 )
 
 func DBConfig() *gorm.DB {
+	db, err := OpenDB()
+
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+
+	return db
+}
+
+// OpenDB connects to the database without exiting on failure, for callers
+// that report the error themselves.
+func OpenDB() (*gorm.DB, error) {
 	host := DB_HOST.GetValue()
 	user := DB_USER.GetValue()
 	password := DB_PASSWORD.GetValue()
@@ -18,18 +31,64 @@ func DBConfig() *gorm.DB {
 
 	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=Asia/Jakarta", host, user, password, dbname, port)
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	return gorm.Open(postgres.Open(dsn), &gorm.Config{})
+}
 
-	AutoMigrateEntity(db)
+// AutoMigrateEnabled reports whether DB_AUTO_MIGRATE asks for AutoMigrate on
+// startup. It is meant for development, the schema is otherwise owned by the
+// migrations in pkg/migration.
+func AutoMigrateEnabled() bool {
+	enabled, _ := strconv.ParseBool(DB_AUTO_MIGRATE.GetValue())
 
-	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+	return enabled
+}
+
+// AutoMigrateEntity syncs the tables with the entities, for development
+// only. Schema changes still need a migration before they are released.
+func AutoMigrateEntity(db *gorm.DB) error {
+	if err := db.AutoMigrate(
+		&entity.User{},
+		&entity.Tag{},
+		&entity.Category{},
+		&entity.Blog{},
+		&entity.BlogRevision{},
+		&entity.Comment{},
+		&entity.Reaction{},
+		&entity.Follow{},
+		&entity.BookmarkFolder{},
+		&entity.Bookmark{},
+		&entity.BlogViewStat{},
+		&entity.BlogViewReferrer{},
+		&entity.BlogViewVisitor{},
+		&entity.Report{},
+		&entity.Series{},
+		&entity.SeriesBlog{},
+		&entity.BlogContributor{},
+		&entity.BlogTranslation{},
+	); err != nil {
+		return err
 	}
 
-	return db
-}
+	statements := []string{
+		// Keyset pagination walks (created_at, id) in both directions.
+		"CREATE INDEX IF NOT EXISTS idx_users_created_at_id ON users (created_at, id)",
+		"CREATE INDEX IF NOT EXISTS idx_blogs_created_at_id ON blogs (created_at, id)",
+
+		// Related blogs compare titles by trigram similarity and are cached until
+		// the latest change to any blog.
+		"CREATE EXTENSION IF NOT EXISTS pg_trgm",
+		"CREATE INDEX IF NOT EXISTS idx_blogs_title_trgm ON blogs USING gin (title gin_trgm_ops)",
+		"CREATE INDEX IF NOT EXISTS idx_blogs_updated_at ON blogs (updated_at)",
+
+		// An author can not have two live blogs with the same title.
+		"CREATE UNIQUE INDEX IF NOT EXISTS idx_blogs_user_title ON blogs (user_id, LOWER(title)) WHERE deleted_at IS NULL",
+	}
+
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
 
-func AutoMigrateEntity(db *gorm.DB) {
-	db.AutoMigrate(&entity.User{})
-	db.AutoMigrate(&entity.Blog{})
+	return nil
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"strconv"
 
 	"github.com/joho/godotenv"
 )
@@ -10,13 +11,34 @@ type EnvKey string
 
 const (
 	// General
-	PORT    EnvKey = "PORT"
-	API_KEY EnvKey = "API_KEY"
+	PORT     EnvKey = "PORT"
+	API_KEY  EnvKey = "API_KEY"
+	APP_NAME EnvKey = "APP_NAME"
+	APP_URL  EnvKey = "APP_URL"
+
+	// Limits
+	BODY_LIMIT_MB EnvKey = "BODY_LIMIT_MB"
+
+	// Localization
+	DEFAULT_LOCALE EnvKey = "DEFAULT_LOCALE"
 
 	// JWT
 	JWT_SECRET_ACCESS_TOKEN  EnvKey = "JWT_SECRET_ACCESS_TOKEN"
 	JWT_SECRET_REFRESH_TOKEN EnvKey = "JWT_SECRET_REFRESH_TOKEN"
 
+	// Pagination
+	CURSOR_SECRET EnvKey = "CURSOR_SECRET"
+
+	// Analytics
+	VIEW_HASH_SECRET EnvKey = "VIEW_HASH_SECRET"
+
+	// Moderation
+	MODERATION_AUTO_HIDE_REPORTS EnvKey = "MODERATION_AUTO_HIDE_REPORTS"
+	MODERATION_BANNED_WORDS      EnvKey = "MODERATION_BANNED_WORDS"
+
+	// Trash
+	TRASH_RETENTION_DAYS EnvKey = "TRASH_RETENTION_DAYS"
+
 	// Database
 	DB_HOST     EnvKey = "DB_HOST"
 	DB_USER     EnvKey = "DB_USER"
@@ -24,6 +46,9 @@ const (
 	DB_NAME     EnvKey = "DB_NAME"
 	DB_PORT     EnvKey = "DB_PORT"
 
+	// DB_AUTO_MIGRATE runs AutoMigrate after the migrations, development only
+	DB_AUTO_MIGRATE EnvKey = "DB_AUTO_MIGRATE"
+
 	// S3
 	S3_ACCESS_KEY EnvKey = "S3_ACCESS_KEY"
 	S3_SECRET_KEY EnvKey = "S3_SECRET_KEY"
@@ -40,3 +65,14 @@ func LoadEnv() error {
 func (e EnvKey) GetValue() string {
 	return os.Getenv(string(e))
 }
+
+// BodyLimit is BODY_LIMIT_MB in bytes, defaulting to the 4 MB Fiber uses.
+func BodyLimit() int {
+	limit, err := strconv.Atoi(BODY_LIMIT_MB.GetValue())
+
+	if err != nil || limit <= 0 {
+		return 4 * 1024 * 1024
+	}
+
+	return limit * 1024 * 1024
+}
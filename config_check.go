@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"learn/fiber/config"
+	"learn/fiber/pkg/migration"
+	"learn/fiber/utils"
+	"net/url"
+	"os"
+	"strconv"
+	"text/tabwriter"
+)
+
+const (
+	checkError   = "error"
+	checkWarning = "warning"
+)
+
+// minSecretLength is the length below which a JWT secret is reported, HS256
+// keys should be at least as long as the hash.
+const minSecretLength = 32
+
+type configProblem struct {
+	level   string
+	key     string
+	message string
+}
+
+// requiredEnv lists the keys the server can not work without.
+var requiredEnv = []config.EnvKey{
+	config.API_KEY,
+	config.JWT_SECRET_ACCESS_TOKEN,
+	config.JWT_SECRET_REFRESH_TOKEN,
+	config.DB_HOST,
+	config.DB_USER,
+	config.DB_NAME,
+	config.DB_PORT,
+	config.S3_ACCESS_KEY,
+	config.S3_SECRET_KEY,
+	config.S3_BUCKET,
+	config.S3_ENDPOINT,
+	config.S3_REGION,
+	config.S3_SERVE_URL,
+}
+
+// runConfig handles `config check`, which reports invalid settings and
+// pending migrations and fails when any of them is an error.
+func runConfig(args []string) error {
+	if len(args) != 1 || args[0] != "check" {
+		return usageError("config only has the check command")
+	}
+
+	problems := checkEnv()
+	problems = append(problems, checkDatabase()...)
+
+	if len(problems) == 0 {
+		fmt.Println("Configuration is valid")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "LEVEL\tKEY\tPROBLEM")
+
+	failed := 0
+
+	for _, problem := range problems {
+		if problem.level == checkError {
+			failed++
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\n", problem.level, problem.key, problem.message)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("configuration has %d errors", failed)
+	}
+
+	return nil
+}
+
+func checkEnv() []configProblem {
+	problems := []configProblem{}
+	report := func(level string, key config.EnvKey, message string) {
+		problems = append(problems, configProblem{level: level, key: string(key), message: message})
+	}
+
+	for _, key := range requiredEnv {
+		if key.GetValue() == "" {
+			report(checkError, key, "is required")
+		}
+	}
+
+	for _, key := range []config.EnvKey{config.JWT_SECRET_ACCESS_TOKEN, config.JWT_SECRET_REFRESH_TOKEN} {
+		if value := key.GetValue(); value != "" && len(value) < minSecretLength {
+			report(checkWarning, key, fmt.Sprintf("is shorter than %d characters", minSecretLength))
+		}
+	}
+
+	if value := config.PORT.GetValue(); value != "" {
+		if port, err := strconv.Atoi(value); err != nil || port < 1 || port > 65535 {
+			report(checkError, config.PORT, "must be a port number")
+		}
+	}
+
+	if value := config.APP_URL.GetValue(); value == "" {
+		report(checkWarning, config.APP_URL, "is empty, feeds link blogs to this API instead")
+	} else if parsed, err := url.Parse(value); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		report(checkError, config.APP_URL, "must be an absolute URL")
+	}
+
+	if value := config.BODY_LIMIT_MB.GetValue(); value != "" {
+		if limit, err := strconv.Atoi(value); err != nil || limit <= 0 {
+			report(checkWarning, config.BODY_LIMIT_MB, "is not a positive number, 4 MB is used")
+		}
+	}
+
+	for _, key := range []config.EnvKey{config.MODERATION_AUTO_HIDE_REPORTS, config.TRASH_RETENTION_DAYS} {
+		if value := key.GetValue(); value != "" {
+			if number, err := strconv.Atoi(value); err != nil || number < 0 {
+				report(checkWarning, key, "is not a number of at least 0, the default is used")
+			}
+		}
+	}
+
+	if value := config.DEFAULT_LOCALE.GetValue(); value != "" {
+		if _, ok := utils.ParseLocale(value); !ok {
+			report(checkWarning, config.DEFAULT_LOCALE, "is not a supported locale, id is used")
+		}
+	}
+
+	if value := config.DB_AUTO_MIGRATE.GetValue(); value != "" {
+		if _, err := strconv.ParseBool(value); err != nil {
+			report(checkWarning, config.DB_AUTO_MIGRATE, "is not a boolean, AutoMigrate stays off")
+		} else if config.AutoMigrateEnabled() {
+			report(checkWarning, config.DB_AUTO_MIGRATE, "is meant for development only")
+		}
+	}
+
+	return problems
+}
+
+// checkDatabase connects with the configured credentials and counts the
+// pending migrations, it is skipped while the connection settings are
+// missing.
+func checkDatabase() []configProblem {
+	for _, key := range []config.EnvKey{config.DB_HOST, config.DB_USER, config.DB_NAME, config.DB_PORT} {
+		if key.GetValue() == "" {
+			return nil
+		}
+	}
+
+	db, err := config.OpenDB()
+
+	if err != nil {
+		return []configProblem{{level: checkError, key: "database", message: err.Error()}}
+	}
+
+	if sqlDB, err := db.DB(); err == nil {
+		defer sqlDB.Close()
+	}
+
+	migrator, err := migration.NewMigrator(db)
+
+	if err != nil {
+		return []configProblem{{level: checkError, key: "migrations", message: err.Error()}}
+	}
+
+	pending, err := migrator.Pending()
+
+	if err != nil {
+		return []configProblem{{level: checkError, key: "migrations", message: err.Error()}}
+	}
+
+	if pending > 0 {
+		return []configProblem{{
+			level:   checkWarning,
+			key:     "migrations",
+			message: fmt.Sprintf("%d pending, serve applies them on start or run migrate up", pending),
+		}}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"learn/fiber/config"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+	"learn/fiber/pkg/service"
+	"os"
+	"text/tabwriter"
+)
+
+// runImport handles `import wordpress`, it streams the export from disk so
+// exports of any size are imported without going through BODY_LIMIT_MB.
+func runImport(args []string) error {
+	if len(args) == 0 || args[0] != "wordpress" {
+		return usageError("import only has the wordpress command")
+	}
+
+	flags := flag.NewFlagSet("import wordpress", flag.ContinueOnError)
+	skipMedia := flags.Bool("skip-media", false, "keep links to the WordPress uploads and drop featured images instead of copying them")
+
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return usageError("import wordpress takes the path of an export")
+	}
+
+	file, err := os.Open(flags.Arg(0))
+
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	fileService, err := service.NewFileService()
+
+	if err != nil {
+		return err
+	}
+
+	db := config.DBConfig()
+	importService := service.NewWordPressImportService(
+		repository.NewUserRepository(db),
+		repository.NewBlogRepository(db),
+		repository.NewTagRepository(db),
+		repository.NewCategoryRepository(db),
+		repository.NewCommentRepository(db),
+		fileService,
+	)
+
+	report, err := importService.Import(file, &req.WordPressImportRequest{SkipMedia: *skipMedia})
+
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KIND\tCREATED\tSKIPPED\tFAILED")
+
+	for _, row := range []struct {
+		kind  string
+		count res.ImportCount
+	}{
+		{"users", report.Users},
+		{"categories", report.Categories},
+		{"tags", report.Tags},
+		{"blogs", report.Blogs},
+		{"comments", report.Comments},
+		{"media", report.Media},
+	} {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", row.kind, row.count.Created, row.count.Skipped, row.count.Failed)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for _, item := range report.Items {
+		fmt.Printf("%s %s %s: %s\n", item.Status, item.Kind, item.Source, item.Reason)
+	}
+
+	return nil
+}
@@ -3,24 +3,11 @@ package main
 import (
 	"learn/fiber/config"
 	_ "learn/fiber/docs"
-	"learn/fiber/pkg/err"
-	"learn/fiber/pkg/handler"
-	"learn/fiber/pkg/middleware"
-	"learn/fiber/pkg/repository"
-	"learn/fiber/pkg/router"
-	"learn/fiber/pkg/service"
 	"learn/fiber/utils"
-	"time"
+	"os"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/log"
-	"github.com/gofiber/fiber/v2/middleware/compress"
-	"github.com/gofiber/fiber/v2/middleware/cors"
-	"github.com/gofiber/fiber/v2/middleware/limiter"
-	"github.com/gofiber/fiber/v2/middleware/logger"
-	"github.com/gofiber/fiber/v2/middleware/monitor"
-	"github.com/gofiber/fiber/v2/middleware/recover"
-	"github.com/gofiber/swagger"
 )
 
 //	@title			         Swagger Fiber API Docs
@@ -46,69 +33,9 @@ func main() {
 		log.Errorf("Failed to load environment variables: %v", err)
 	}
 
-	app := fiber.New(fiber.Config{
-		ErrorHandler: err.ErrorHandler,
-	})
-
-	port := ":" + config.PORT.GetValue()
-
-	if port == "" {
-		port = ":3000"
-	}
-
-	db := config.DBConfig()
-
-	// Init Repository
-	userRepository := repository.NewUserRepository(db)
-	blogRepository := repository.NewBlogRepository(db)
-
-	// Init Service
-	userService := service.NewUserService(userRepository)
-	blogService := service.NewBlogService(blogRepository, userRepository)
-	fileService, err := service.NewFileService()
-
-	if err != nil {
-		log.Fatalf("Error creating file service: %v", err)
+	if err := run(os.Args[1:]); err != nil {
+		log.Fatal(err)
 	}
-
-	// Init Handler
-	userHandler := handler.NewUserHandler(userService)
-	blogHandler := handler.NewBlogHandler(blogService)
-	fileHandler := handler.NewFileHandler(fileService)
-
-	app.Use(logger.New())
-	app.Use(cors.New(cors.Config{
-		AllowOrigins: "*",
-		AllowMethods: "GET,POST,PUT,DELETE,OPTIONS",
-		AllowHeaders: "Origin, Content-Type, Accept",
-	}))
-	app.Use(compress.New(compress.Config{
-		Level: compress.LevelBestSpeed,
-	}))
-	app.Use(recover.New())
-	app.Use(middleware.LimitUploadSize())
-	app.Use(limiter.New(limiter.Config{
-		Max:        30,
-		Expiration: 10 * time.Second,
-		LimitReached: func(c *fiber.Ctx) error {
-			return fiber.NewError(fiber.StatusTooManyRequests, "Sorry, To Many Request. Please try again later.")
-		},
-	}))
-
-	app.Get("/swagger/*", swagger.HandlerDefault)
-
-	route := app.Group("/api/v1")
-
-	route.Get("/", RootHandler)
-	route.Get("/metrics", monitor.New(monitor.Config{Title: "Fiber Metrics Page"}))
-
-	// Init Router
-	router.UserRouter(route, userHandler)
-	router.BlogRouter(route, blogHandler)
-	router.FileRouter(route, fileHandler)
-
-	log.Infof("Server running on http://127.0.0.1%s/api/v1 🚀", port)
-	log.Fatal(app.Listen(port))
 }
 
 // @Summary		    Root Endpoint
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"learn/fiber/config"
+	"learn/fiber/pkg/migration"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gofiber/fiber/v2/log"
+	"gorm.io/gorm"
+)
+
+// migrateDatabase brings the schema up to date before the server starts.
+// Concurrent instances wait on the migration lock, so only one applies them.
+func migrateDatabase(db *gorm.DB) error {
+	migrator, err := migration.NewMigrator(db)
+
+	if err != nil {
+		return err
+	}
+
+	applied, err := migrator.Up()
+
+	for _, m := range applied {
+		log.Infof("Applied migration %s_%s", m.Version, m.Name)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if config.AutoMigrateEnabled() {
+		log.Warn("DB_AUTO_MIGRATE is enabled, write a migration for any schema change before releasing it")
+
+		return config.AutoMigrateEntity(db)
+	}
+
+	return nil
+}
+
+// runMigrate handles `migrate up|down|status|create` from the command line.
+func runMigrate(args []string) error {
+	if len(args) == 0 {
+		return usageError("migrate needs a command")
+	}
+
+	// create only writes files and works without a database.
+	if args[0] == "create" {
+		if len(args) != 2 {
+			return usageError("migrate create takes a name")
+		}
+
+		paths, err := migration.Create(migration.Dir, args[1])
+
+		for _, path := range paths {
+			fmt.Println("Created", path)
+		}
+
+		return err
+	}
+
+	migrator, err := migration.NewMigrator(config.DBConfig())
+
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up()
+
+		for _, m := range applied {
+			fmt.Printf("Applied %s_%s\n", m.Version, m.Name)
+		}
+
+		if err == nil && len(applied) == 0 {
+			fmt.Println("Nothing to migrate")
+		}
+
+		return err
+	case "down":
+		steps := 1
+
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+
+			if err != nil || steps < 1 {
+				return errors.New("steps must be a positive number")
+			}
+		}
+
+		reverted, err := migrator.Down(steps)
+
+		for _, m := range reverted {
+			fmt.Printf("Rolled back %s_%s\n", m.Version, m.Name)
+		}
+
+		if err == nil && len(reverted) == 0 {
+			fmt.Println("Nothing to roll back")
+		}
+
+		return err
+	case "status":
+		statuses, err := migrator.Status()
+
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+
+		for _, status := range statuses {
+			appliedAt := "pending"
+
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format(time.RFC3339)
+			}
+
+			if status.Missing {
+				appliedAt += " (not in this build)"
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+
+		return w.Flush()
+	default:
+		return usageError(fmt.Sprintf("unknown migrate command %q", args[0]))
+	}
+}
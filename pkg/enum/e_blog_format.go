@@ -0,0 +1,9 @@
+package enum
+
+type EBlogFormat string
+
+const (
+	BLOG_FORMAT_MARKDOWN EBlogFormat = "markdown"
+	BLOG_FORMAT_HTML     EBlogFormat = "html"
+	BLOG_FORMAT_PLAIN    EBlogFormat = "plain"
+)
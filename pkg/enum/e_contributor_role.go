@@ -0,0 +1,11 @@
+package enum
+
+type EContributorRole string
+
+// The author is the owner of the blog (Blog.UserId) and is never stored as a
+// contributor, co-authors and editors are.
+const (
+	CONTRIBUTOR_ROLE_AUTHOR    EContributorRole = "author"
+	CONTRIBUTOR_ROLE_CO_AUTHOR EContributorRole = "co-author"
+	CONTRIBUTOR_ROLE_EDITOR    EContributorRole = "editor"
+)
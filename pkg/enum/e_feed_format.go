@@ -0,0 +1,9 @@
+package enum
+
+type EFeedFormat string
+
+const (
+	FEED_FORMAT_RSS  EFeedFormat = "rss"
+	FEED_FORMAT_ATOM EFeedFormat = "atom"
+	FEED_FORMAT_JSON EFeedFormat = "json"
+)
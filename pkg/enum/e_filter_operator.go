@@ -0,0 +1,14 @@
+package enum
+
+type EFilterOperator string
+
+const (
+	FILTER_EQ   EFilterOperator = "eq"
+	FILTER_NE   EFilterOperator = "ne"
+	FILTER_GT   EFilterOperator = "gt"
+	FILTER_GTE  EFilterOperator = "gte"
+	FILTER_LT   EFilterOperator = "lt"
+	FILTER_LTE  EFilterOperator = "lte"
+	FILTER_LIKE EFilterOperator = "like"
+	FILTER_IN   EFilterOperator = "in"
+)
@@ -0,0 +1,18 @@
+package enum
+
+// EIdPrefix starts the id of an entity, followed by a dash and a UUID, so an
+// id tells what it points at.
+type EIdPrefix string
+
+const (
+	ID_PREFIX_USER     EIdPrefix = "user"
+	ID_PREFIX_BLOG     EIdPrefix = "blog"
+	ID_PREFIX_REVISION EIdPrefix = "revision"
+	ID_PREFIX_TAG      EIdPrefix = "tag"
+	ID_PREFIX_CATEGORY EIdPrefix = "category"
+	ID_PREFIX_COMMENT  EIdPrefix = "comment"
+	ID_PREFIX_REACTION EIdPrefix = "reaction"
+	ID_PREFIX_REPORT   EIdPrefix = "report"
+	ID_PREFIX_SERIES   EIdPrefix = "series"
+	ID_PREFIX_FOLDER   EIdPrefix = "folder"
+)
@@ -0,0 +1,11 @@
+package enum
+
+type EImportStatus string
+
+const (
+	IMPORT_STATUS_CREATED   EImportStatus = "created"
+	IMPORT_STATUS_UPDATED   EImportStatus = "updated"
+	IMPORT_STATUS_UNCHANGED EImportStatus = "unchanged"
+	IMPORT_STATUS_SKIPPED   EImportStatus = "skipped"
+	IMPORT_STATUS_FAILED    EImportStatus = "failed"
+)
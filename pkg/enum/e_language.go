@@ -0,0 +1,11 @@
+package enum
+
+// ELanguage names a PostgreSQL text search configuration, it decides how blog
+// titles and bodies are stemmed for full-text search.
+type ELanguage string
+
+const (
+	LANGUAGE_ENGLISH    ELanguage = "english"
+	LANGUAGE_INDONESIAN ELanguage = "indonesian"
+	LANGUAGE_SIMPLE     ELanguage = "simple"
+)
@@ -0,0 +1,13 @@
+package enum
+
+// ELocale is a language tag content is written in, unlike ELanguage it is
+// only used to pick which translation of a blog is served.
+type ELocale string
+
+const (
+	LOCALE_ENGLISH    ELocale = "en"
+	LOCALE_INDONESIAN ELocale = "id"
+)
+
+// Locales are the locales translations can be written in.
+var Locales = []ELocale{LOCALE_ENGLISH, LOCALE_INDONESIAN}
@@ -0,0 +1,18 @@
+package enum
+
+type EReaction string
+
+const (
+	REACTION_LIKE  EReaction = "like"
+	REACTION_LOVE  EReaction = "love"
+	REACTION_LAUGH EReaction = "laugh"
+	REACTION_WOW   EReaction = "wow"
+	REACTION_SAD   EReaction = "sad"
+)
+
+type EReactionTarget string
+
+const (
+	REACTION_TARGET_BLOG    EReactionTarget = "blog"
+	REACTION_TARGET_COMMENT EReactionTarget = "comment"
+)
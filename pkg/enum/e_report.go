@@ -0,0 +1,29 @@
+package enum
+
+type EReportTarget string
+
+const (
+	REPORT_TARGET_BLOG    EReportTarget = "blog"
+	REPORT_TARGET_COMMENT EReportTarget = "comment"
+)
+
+type EReportReason string
+
+const (
+	REPORT_REASON_SPAM           EReportReason = "spam"
+	REPORT_REASON_HARASSMENT     EReportReason = "harassment"
+	REPORT_REASON_HATE           EReportReason = "hate"
+	REPORT_REASON_NSFW           EReportReason = "nsfw"
+	REPORT_REASON_MISINFORMATION EReportReason = "misinformation"
+	REPORT_REASON_OTHER          EReportReason = "other"
+)
+
+// EReportStatus is pending until a moderator acts on the reported content,
+// actioned when it got hidden and dismissed when it was left visible.
+type EReportStatus string
+
+const (
+	REPORT_STATUS_PENDING   EReportStatus = "pending"
+	REPORT_STATUS_ACTIONED  EReportStatus = "actioned"
+	REPORT_STATUS_DISMISSED EReportStatus = "dismissed"
+)
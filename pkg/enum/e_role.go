@@ -3,6 +3,7 @@ package enum
 type ERole string
 
 const (
-	ROLE_ADMIN ERole = "admin"
-	ROLE_USER  ERole = "user"
+	ROLE_ADMIN     ERole = "admin"
+	ROLE_MODERATOR ERole = "moderator"
+	ROLE_USER      ERole = "user"
 )
@@ -3,6 +3,7 @@ package err
 import (
 	"errors"
 	"learn/fiber/pkg/model"
+	"learn/fiber/utils"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -10,10 +11,16 @@ import (
 func ErrorHandler(c *fiber.Ctx, err error) error {
 	code := fiber.StatusInternalServerError
 	message := "Internal Server Error"
+	var data any
 
 	var e *fiber.Error
+	var validationError *utils.ValidationError
 
-	if errors.As(err, &e) {
+	if errors.As(err, &validationError) {
+		code = validationError.Code
+		message = "Validation failed"
+		data = validationError.Errors
+	} else if errors.As(err, &e) {
 		code = e.Code
 		message = e.Message
 	}
@@ -22,6 +29,7 @@ func ErrorHandler(c *fiber.Ctx, err error) error {
 		ResponseEntity: model.ResponseEntity[any]{
 			Code:    code,
 			Message: message,
+			Data:    data,
 		},
 		Path: c.Path(),
 	}
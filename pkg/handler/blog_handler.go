@@ -6,39 +6,51 @@ import (
 	"learn/fiber/pkg/model/req"
 	"learn/fiber/pkg/service"
 	"learn/fiber/utils"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 )
 
 type BlogHandler struct {
-	blogService service.BlogService
-	validator   *validator.Validate
+	blogService     service.BlogService
+	blogViewService service.BlogViewService
+	validator       *validator.Validate
 }
 
-func NewBlogHandler(blogService service.BlogService) *BlogHandler {
+func NewBlogHandler(blogService service.BlogService, blogViewService service.BlogViewService) *BlogHandler {
 	return &BlogHandler{
-		blogService: blogService,
-		validator:   validator.New(),
+		blogService:     blogService,
+		blogViewService: blogViewService,
+		validator:       utils.NewValidator(),
 	}
 }
 
 // @Summary		    Create Blog
-// @Description	Create a new blog
+// @Description	Create a new blog, image must be a URL or file key returned by the upload endpoint and titles are unique per author
 // @Tags			       Blog
 // @Accept			     json
 // @Produce		    json
 // @Security		        BearerAuth
 // @Param			request	body	req.CreateBlogDto	true	"Create Blog Request Payload"
+// @Success		201		{object}	model.ResponseEntity[res.FindBlogResponse]
+// @Failure		400		{object}	model.ResponseError[[]model.FieldError]
+// @Failure		409		{object}	model.ResponseError[[]model.FieldError]
 // @Router			     /blog [post]
 func (b *BlogHandler) CreateBlogHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
 	var payload req.CreateBlogDto
 
 	if err := utils.ValidateRequestBody(c, b.validator, &payload); err != nil {
 		return err
 	}
 
-	blog, err := b.blogService.CreateBlog(&payload, c.Locals("payload").(model.JwtPayload).Id)
+	blog, err := b.blogService.CreateBlog(&payload, currentUser.Id)
 
 	if err != nil {
 		return err
@@ -48,21 +60,30 @@ func (b *BlogHandler) CreateBlogHandler(c *fiber.Ctx) error {
 }
 
 // @Summary		    Find All Blogs Paginate
-// @Description	Get a list of all Blogs with pagination
+// @Description	Get a list of all Blogs with pagination. Sort with sort=-createdAt,title on title, owner, createdAt or updatedAt. Filter with filter[field]=value or filter[field][op]=value on id, title, userId, owner (eq, ne, like, in), language (eq, ne, in), createdAt and updatedAt (eq, ne, gt, gte, lt, lte). Blogs are translated like Find Blog By Id using lang and Accept-Language
 // @Tags			      Blog
 // @Accept			     json
 // @Produce		    json
-// @Param			request	query	model.PaginationRequest	true		"Pagination Request Payload"
+// @Param			request	query	req.BlogPaginationRequest	true		"Pagination Request Payload, tag and category take comma separated slugs that must all match"
 // @Success		 		 		200						{object}	model.ResponseEntityPagination[[]res.FindBlogResponse]
 // @Failure		 		 		401						{object}	model.ResponseError[any]
 // @Router			     /blog/paginate [get]
 func (b *BlogHandler) FindAllPaginateHandler(c *fiber.Ctx) error {
-	var params model.PaginationRequest
+	var params req.BlogPaginationRequest
 
 	if err := c.QueryParser(&params); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
+	filters, err := utils.ParseFilters(c)
+
+	if err != nil {
+		return err
+	}
+
+	params.Filters = filters
+	params.Locales = utils.PreferredLocales(params.Lang, c.Get(fiber.HeaderAcceptLanguage))
+
 	if params.Page <= 0 {
 		params.Page = 1
 	}
@@ -71,12 +92,14 @@ func (b *BlogHandler) FindAllPaginateHandler(c *fiber.Ctx) error {
 		params.Limit = 5
 	}
 
-	meta, blogs, err := b.blogService.FindAllPaginate(&params)
+	meta, blogs, err := b.blogService.FindAllPaginate(&params, utils.ViewerId(c))
 
 	if err != nil {
 		return err
 	}
 
+	c.Vary(fiber.HeaderAcceptLanguage)
+
 	return utils.SuccessResponsePaginate(
 		c,
 		fiber.StatusOK,
@@ -86,8 +109,81 @@ func (b *BlogHandler) FindAllPaginateHandler(c *fiber.Ctx) error {
 	)
 }
 
+// @Summary		Find All Blogs Cursor
+// @Description	Get blogs newest first with keyset pagination, pass nextCursor or prevCursor from meta back as cursor to move between pages
+// @Tags			Blog
+// @Accept			json
+// @Produce		json
+// @Param			request	query		req.BlogCursorRequest	true	"Cursor Pagination Request Payload"
+// @Success		200		{object}	model.ResponseEntityCursor[[]res.FindBlogResponse]
+// @Failure		400		{object}	model.ResponseError[any]
+// @Router			/blog/cursor [get]
+func (b *BlogHandler) FindAllCursorHandler(c *fiber.Ctx) error {
+	var params req.BlogCursorRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if err := b.validator.Struct(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = 5
+	}
+
+	meta, blogs, err := b.blogService.FindAllCursor(&params, utils.ViewerId(c))
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponseCursor(c, fiber.StatusOK, "Success Find All Blogs Cursor", blogs, meta)
+}
+
+// @Summary		Find Following Feed
+// @Description	Get the blogs of the authors the current user follows, newest first
+// @Tags			Blog
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	query		model.PaginationRequest	false	"Pagination Request Payload"
+// @Success		200		{object}	model.ResponseEntityPagination[[]res.FindBlogResponse]
+// @Failure		401		{object}	model.ResponseError[any]
+// @Router			/blog/feed [get]
+func (b *BlogHandler) FindFollowingFeedHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var params model.PaginationRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = 5
+	}
+
+	meta, blogs, err := b.blogService.FindFollowingFeed(currentUser.Id, &params)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponsePaginate(c, fiber.StatusOK, "Success Find Following Feed", blogs, meta)
+}
+
 // @Summary		    Find Blog By Id
-// @Description	Get Blog details by ID
+// @Description	Get Blog details by ID, series holds the previous and next posts when the blog is part of a series
 // @Tags			      Blog
 // @Accept			     json
 // @Produce		    json
@@ -99,11 +195,204 @@ func (b *BlogHandler) FindAllPaginateHandler(c *fiber.Ctx) error {
 func (b *BlogHandler) FindBlogByIdHandler(c *fiber.Ctx) error {
 	id := c.Params("id")
 
-	blog, err := b.blogService.FindById(id)
+	viewerId := utils.ViewerId(c)
+	locales := utils.PreferredLocales(c.Query("lang"), c.Get(fiber.HeaderAcceptLanguage))
+
+	blog, err := b.blogService.FindById(id, viewerId, locales)
 
 	if err != nil {
 		return err
 	}
 
+	c.Vary(fiber.HeaderAcceptLanguage)
+	c.Set(fiber.HeaderContentLanguage, blog.Locale)
+
+	// Authors reading their own blog are not counted as views.
+	if viewerId != blog.UserId {
+		b.blogViewService.Track(service.BlogView{
+			BlogId:   blog.ID,
+			Visitor:  utils.HashVisitor(viewerId, c.IP(), c.Get(fiber.HeaderUserAgent)),
+			Referrer: c.Get(fiber.HeaderReferer),
+			ViewedAt: time.Now(),
+		})
+	}
+
 	return utils.SuccessResponse(c, fiber.StatusOK, fmt.Sprintf("Success Get blog %s", blog.Title), blog)
 }
+
+// @Summary		Update Blog By Id
+// @Description	Edit a blog as its owner, a co-author, an editor or an admin, every edit is stored as a revision
+// @Tags			Blog
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string				true	"Blog ID"
+// @Param			request	body		req.UpdateBlogDto	true	"Update Blog Request Payload"
+// @Success		200		{object}	model.ResponseEntity[res.FindBlogResponse]
+// @Failure		403		{object}	model.ResponseError[any]
+// @Failure		404		{object}	model.ResponseError[any]
+// @Router			/blog/{id} [put]
+func (b *BlogHandler) UpdateBlogHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var payload req.UpdateBlogDto
+
+	if err := utils.ValidateRequestBody(c, b.validator, &payload); err != nil {
+		return err
+	}
+
+	blog, err := b.blogService.UpdateBlog(c.Params("id"), &payload, currentUser)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Update Blog", blog)
+}
+
+// @Summary		Search Blogs
+// @Description	Full-text search over blog titles and bodies ranked by relevance, q accepts web search syntax such as quoted phrases, OR and -exclusions, titleHighlight and snippet are HTML escaped with the matches wrapped in <mark>
+// @Tags			Blog
+// @Accept			json
+// @Produce		json
+// @Param			request	query		req.SearchBlogRequest	true	"Search Request Payload"
+// @Success		200		{object}	model.ResponseEntityPagination[[]res.SearchBlogResponse]
+// @Failure		400		{object}	model.ResponseError[any]
+// @Router			/blog/search [get]
+func (b *BlogHandler) SearchBlogHandler(c *fiber.Ctx) error {
+	var params req.SearchBlogRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if err := b.validator.Struct(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = 5
+	}
+
+	meta, blogs, err := b.blogService.Search(&params, utils.ViewerId(c))
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponsePaginate(c, fiber.StatusOK, "Success Search Blogs", blogs, meta)
+}
+
+// @Summary		Find Related Blogs
+// @Description	Get the blogs most similar to a blog, scored by shared tags and categories plus title and text similarity. excludeAuthor leaves out other blogs of the same author. Blogs are translated like Find Blog By Id using lang and Accept-Language
+// @Tags			Blog
+// @Accept			json
+// @Produce		json
+// @Param			id		path		string					true	"Blog ID"
+// @Param			request	query		req.RelatedBlogRequest	false	"Related Request Payload"
+// @Success		200		{object}	model.ResponseEntity[[]res.RelatedBlogResponse]
+// @Failure		400		{object}	model.ResponseError[any]
+// @Failure		404		{object}	model.ResponseError[any]
+// @Router			/blog/{id}/related [get]
+func (b *BlogHandler) FindRelatedBlogsHandler(c *fiber.Ctx) error {
+	var params req.RelatedBlogRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if err := b.validator.Struct(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = 5
+	}
+
+	params.Locales = utils.PreferredLocales(params.Lang, c.Get(fiber.HeaderAcceptLanguage))
+
+	blogs, err := b.blogService.FindRelated(c.Params("id"), &params, utils.ViewerId(c))
+
+	if err != nil {
+		return err
+	}
+
+	c.Vary(fiber.HeaderAcceptLanguage)
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Find Related Blogs", blogs)
+}
+
+// @Summary		Export Blogs
+// @Description	Download the blogs of a user as a zip of Markdown files with YAML front matter (title, slug, dates, tags, categories, image) and the media they use. Defaults to the current user, only an admin can export another user
+// @Tags			Blog
+// @Produce		application/zip
+// @Security		BearerAuth
+// @Param			request	query		req.BlogExportRequest	false	"Export Request"
+// @Success		200		{file}		file
+// @Failure		403		{object}	model.ResponseError[any]
+// @Failure		404		{object}	model.ResponseError[any]
+// @Router			/blog/export [get]
+func (b *BlogHandler) ExportBlogsHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var params req.BlogExportRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	archive, err := b.blogService.ExportArchive(params.UserId, currentUser)
+
+	if err != nil {
+		return err
+	}
+
+	c.Set(fiber.HeaderContentType, "application/zip")
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="blogs-%s.zip"`, time.Now().Format("2006-01-02")))
+
+	return c.Send(archive)
+}
+
+// @Summary		Import Blogs
+// @Description	Create or update blogs of the current user from a zip made by the export endpoint or a static site generator, posts are .md files with YAML front matter and media goes in a media folder. Posts are matched by id, then by slug, so importing the same archive twice changes nothing
+// @Tags			Blog
+// @Accept			multipart/form-data
+// @Produce		json
+// @Security		BearerAuth
+// @Param			file	formData	file	true	"Zip archive"
+// @Success		200		{object}	model.ResponseEntity[res.BlogImportResponse]
+// @Failure		400		{object}	model.ResponseError[any]
+// @Router			/blog/import [post]
+func (b *BlogHandler) ImportBlogsHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	file, err := c.FormFile("file")
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "File is required")
+	}
+
+	report, err := b.blogService.ImportArchive(file, currentUser)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Import Blogs", report)
+}
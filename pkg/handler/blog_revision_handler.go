@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type BlogRevisionHandler struct {
+	blogRevisionService service.BlogRevisionService
+	validator           *validator.Validate
+}
+
+func NewBlogRevisionHandler(blogRevisionService service.BlogRevisionService) *BlogRevisionHandler {
+	return &BlogRevisionHandler{
+		blogRevisionService: blogRevisionService,
+		validator:           utils.NewValidator(),
+	}
+}
+
+// @Summary		Find Blog Revisions Paginate
+// @Description	List the revisions of a blog, newest first, as its owner or an admin
+// @Tags			Blog Revision
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string					true	"Blog ID"
+// @Param			request	query		model.PaginationRequest	false	"Pagination Request Payload"
+// @Success		200		{object}	model.ResponseEntityPagination[[]res.BlogRevisionResponse]
+// @Failure		403		{object}	model.ResponseError[any]
+// @Failure		404		{object}	model.ResponseError[any]
+// @Router			/blog/{id}/revisions [get]
+func (h *BlogRevisionHandler) FindAllPaginateHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var params model.PaginationRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = 10
+	}
+
+	meta, revisions, err := h.blogRevisionService.FindAllPaginate(c.Params("id"), &params, currentUser)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponsePaginate(c, fiber.StatusOK, "Success Find All Blog Revisions Paginate", revisions, meta)
+}
+
+// @Summary		Diff Blog Revisions
+// @Description	Unified diff between two revisions of a blog
+// @Tags			Blog Revision
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string						true	"Blog ID"
+// @Param			request	query		req.BlogRevisionDiffRequest	true	"Revisions to compare"
+// @Success		200		{object}	model.ResponseEntity[res.BlogRevisionDiffResponse]
+// @Failure		403		{object}	model.ResponseError[any]
+// @Failure		404		{object}	model.ResponseError[any]
+// @Router			/blog/{id}/revisions/diff [get]
+func (h *BlogRevisionHandler) DiffHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var params req.BlogRevisionDiffRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if err := h.validator.Struct(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	diff, err := h.blogRevisionService.Diff(c.Params("id"), &params, currentUser)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Diff Blog Revisions", diff)
+}
+
+// @Summary		Find Blog Revision
+// @Description	Get a single revision of a blog including its body
+// @Tags			Blog Revision
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"Blog ID"
+// @Param			rev	path		int		true	"Revision number"
+// @Success		200	{object}	model.ResponseEntity[res.BlogRevisionDetailResponse]
+// @Failure		403	{object}	model.ResponseError[any]
+// @Failure		404	{object}	model.ResponseError[any]
+// @Router			/blog/{id}/revisions/{rev} [get]
+func (h *BlogRevisionHandler) FindByNumberHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	number, err := c.ParamsInt("rev")
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Revision number must be an integer")
+	}
+
+	revision, err := h.blogRevisionService.FindByNumber(c.Params("id"), number, currentUser)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Find Blog Revision", revision)
+}
+
+// @Summary		Restore Blog Revision
+// @Description	Restore a blog to an earlier revision, the restore is recorded as a new revision
+// @Tags			Blog Revision
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"Blog ID"
+// @Param			rev	path		int		true	"Revision number"
+// @Success		200	{object}	model.ResponseEntity[res.FindBlogResponse]
+// @Failure		403	{object}	model.ResponseError[any]
+// @Failure		404	{object}	model.ResponseError[any]
+// @Router			/blog/{id}/revisions/{rev}/restore [post]
+func (h *BlogRevisionHandler) RestoreHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	number, err := c.ParamsInt("rev")
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Revision number must be an integer")
+	}
+
+	blog, err := h.blogRevisionService.Restore(c.Params("id"), number, currentUser)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Restore Blog Revision", blog)
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type BlogTranslationHandler struct {
+	blogTranslationService service.BlogTranslationService
+	validator              *validator.Validate
+}
+
+func NewBlogTranslationHandler(blogTranslationService service.BlogTranslationService) *BlogTranslationHandler {
+	return &BlogTranslationHandler{
+		blogTranslationService: blogTranslationService,
+		validator:              utils.NewValidator(),
+	}
+}
+
+// @Summary		Find Blog Translations
+// @Description	List the translations of a blog
+// @Tags			Translation
+// @Accept			json
+// @Produce		json
+// @Param			id	path		string	true	"Blog ID"
+// @Success		200	{object}	model.Response{data=[]res.BlogTranslationResponse}
+// @Failure		404	{object}	model.ErrorResponse
+// @Router			/blog/{id}/translations [get]
+func (h *BlogTranslationHandler) FindTranslationsHandler(c *fiber.Ctx) error {
+	translations, err := h.blogTranslationService.FindTranslations(c.Params("id"))
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Find Translations", translations)
+}
+
+// @Summary		Save Blog Translation
+// @Description	Add or replace the translation of a blog in a locale, the body uses the format of the blog. Whoever can edit the blog can translate it
+// @Tags			Translation
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string					true	"Blog ID"
+// @Param			locale	path		string					true	"Locale"	Enums(en, id)
+// @Param			request	body		req.BlogTranslationDto	true	"Translation Request Payload"
+// @Success		200		{object}	model.Response{data=res.BlogTranslationResponse}
+// @Success		201		{object}	model.Response{data=res.BlogTranslationResponse}
+// @Failure		400		{object}	model.ErrorResponse{data=[]model.FieldError}
+// @Failure		403		{object}	model.ErrorResponse
+// @Failure		404		{object}	model.ErrorResponse
+// @Router			/blog/{id}/translations/{locale} [put]
+func (h *BlogTranslationHandler) SaveTranslationHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var payload req.BlogTranslationDto
+
+	if err := utils.ValidateRequestBody(c, h.validator, &payload); err != nil {
+		return err
+	}
+
+	translation, created, err := h.blogTranslationService.SaveTranslation(c.Params("id"), c.Params("locale"), &payload, currentUser)
+
+	if err != nil {
+		return err
+	}
+
+	if created {
+		return utils.SuccessResponse(c, fiber.StatusCreated, "Success Create Translation", translation)
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Update Translation", translation)
+}
+
+// @Summary		Delete Blog Translation
+// @Description	Delete the translation of a blog in a locale
+// @Tags			Translation
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string	true	"Blog ID"
+// @Param			locale	path		string	true	"Locale"	Enums(en, id)
+// @Success		200		{object}	model.Response
+// @Failure		403		{object}	model.ErrorResponse
+// @Failure		404		{object}	model.ErrorResponse
+// @Router			/blog/{id}/translations/{locale} [delete]
+func (h *BlogTranslationHandler) DeleteTranslationHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	if err := h.blogTranslationService.DeleteTranslation(c.Params("id"), c.Params("locale"), currentUser); err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse[*struct{}](c, fiber.StatusOK, "Success Delete Translation", nil)
+}
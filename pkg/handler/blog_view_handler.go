@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type BlogViewHandler struct {
+	blogViewService service.BlogViewService
+	validator       *validator.Validate
+}
+
+func NewBlogViewHandler(blogViewService service.BlogViewService) *BlogViewHandler {
+	return &BlogViewHandler{
+		blogViewService: blogViewService,
+		validator:       utils.NewValidator(),
+	}
+}
+
+// @Summary		Blog Stats
+// @Description	Views per day, unique visitors and top referrers of a blog for its author or an admin. Views are written in batches, so the latest few seconds may be missing
+// @Tags			Blog
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string						true	"Blog ID"
+// @Param			request	query		req.BlogViewStatsRequest	false	"Date range, defaults to the last 30 days"
+// @Success		200		{object}	model.Response{data=res.BlogViewStatsResponse}
+// @Failure		403		{object}	model.ErrorResponse
+// @Failure		404		{object}	model.ErrorResponse
+// @Router			/blog/{id}/stats [get]
+func (h *BlogViewHandler) FindStatsHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var params req.BlogViewStatsRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if err := h.validator.Struct(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	stats, err := h.blogViewService.FindStats(c.Params("id"), &params, currentUser)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Find Blog Stats", stats)
+}
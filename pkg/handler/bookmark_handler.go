@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type BookmarkHandler struct {
+	bookmarkService service.BookmarkService
+	validator       *validator.Validate
+}
+
+func NewBookmarkHandler(bookmarkService service.BookmarkService) *BookmarkHandler {
+	return &BookmarkHandler{
+		bookmarkService: bookmarkService,
+		validator:       utils.NewValidator(),
+	}
+}
+
+// @Summary		Toggle Bookmark
+// @Description	Bookmark a blog, optionally into a folder. Sending the same folder again removes the bookmark and sending another folder moves it
+// @Tags			Bookmark
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string				true	"Blog ID"
+// @Param			request	body		req.BookmarkDto		false	"Bookmark Request Payload"
+// @Success		200		{object}	model.Response{data=res.BookmarkResponse}
+// @Failure		404		{object}	model.ErrorResponse
+// @Router			/blog/{id}/bookmark [post]
+func (h *BookmarkHandler) ToggleBookmarkHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var payload req.BookmarkDto
+
+	if len(c.Body()) > 0 {
+		if err := utils.ValidateRequestBody(c, h.validator, &payload); err != nil {
+			return err
+		}
+	}
+
+	bookmark, err := h.bookmarkService.ToggleBookmark(c.Params("id"), &payload, currentUser.Id)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Toggle Bookmark", bookmark)
+}
+
+// @Summary		Remove Bookmark
+// @Description	Remove a blog from the bookmarks, removing a blog that is not bookmarked has no effect
+// @Tags			Bookmark
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"Blog ID"
+// @Success		200	{object}	model.Response{data=res.BookmarkResponse}
+// @Router			/blog/{id}/bookmark [delete]
+func (h *BookmarkHandler) RemoveBookmarkHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	bookmark, err := h.bookmarkService.RemoveBookmark(c.Params("id"), currentUser.Id)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Remove Bookmark", bookmark)
+}
+
+// @Summary		Find My Bookmarks Paginate
+// @Description	List the bookmarked blogs of the current user, most recently bookmarked first, optionally only one folder
+// @Tags			Bookmark
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	query		req.BookmarkPaginationRequest	false	"Bookmark Pagination Request Payload"
+// @Success		200		{object}	model.Response{data=[]res.FindBlogResponse,meta=model.MetaPagination}
+// @Failure		404		{object}	model.ErrorResponse
+// @Router			/user/me/bookmarks [get]
+func (h *BookmarkHandler) FindBookmarksHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var params req.BookmarkPaginationRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if err := h.validator.Struct(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = 10
+	}
+
+	meta, blogs, err := h.bookmarkService.FindBookmarks(currentUser.Id, &params)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponsePaginate(c, fiber.StatusOK, "Success Find All Bookmarks Paginate", blogs, meta)
+}
+
+// @Summary		Find My Bookmark Folders
+// @Description	List the bookmark folders of the current user with their bookmark count
+// @Tags			Bookmark
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Success		200	{object}	model.Response{data=[]res.BookmarkFolderResponse}
+// @Router			/user/me/bookmark-folders [get]
+func (h *BookmarkHandler) FindFoldersHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	folders, err := h.bookmarkService.FindFolders(currentUser.Id)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Find All Bookmark Folders", folders)
+}
+
+// @Summary		Create Bookmark Folder
+// @Description	Create a bookmark folder, names are unique per user
+// @Tags			Bookmark
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body		req.BookmarkFolderDto	true	"Bookmark Folder Request Payload"
+// @Success		201		{object}	model.Response{data=res.BookmarkFolderResponse}
+// @Failure		409		{object}	model.ErrorResponse{data=[]model.FieldError}
+// @Router			/user/me/bookmark-folders [post]
+func (h *BookmarkHandler) CreateFolderHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var payload req.BookmarkFolderDto
+
+	if err := utils.ValidateRequestBody(c, h.validator, &payload); err != nil {
+		return err
+	}
+
+	folder, err := h.bookmarkService.CreateFolder(&payload, currentUser.Id)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusCreated, "Success Create Bookmark Folder", folder)
+}
+
+// @Summary		Update Bookmark Folder
+// @Description	Rename a bookmark folder of the current user
+// @Tags			Bookmark
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			folderId	path		string					true	"Folder ID"
+// @Param			request		body		req.BookmarkFolderDto	true	"Bookmark Folder Request Payload"
+// @Success		200			{object}	model.Response{data=res.BookmarkFolderResponse}
+// @Failure		404			{object}	model.ErrorResponse
+// @Failure		409			{object}	model.ErrorResponse{data=[]model.FieldError}
+// @Router			/user/me/bookmark-folders/{folderId} [put]
+func (h *BookmarkHandler) UpdateFolderHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var payload req.BookmarkFolderDto
+
+	if err := utils.ValidateRequestBody(c, h.validator, &payload); err != nil {
+		return err
+	}
+
+	folder, err := h.bookmarkService.UpdateFolder(c.Params("folderId"), &payload, currentUser.Id)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Update Bookmark Folder", folder)
+}
+
+// @Summary		Delete Bookmark Folder
+// @Description	Delete a bookmark folder of the current user, its bookmarks are kept without a folder
+// @Tags			Bookmark
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			folderId	path		string	true	"Folder ID"
+// @Success		200			{object}	model.Response
+// @Failure		404			{object}	model.ErrorResponse
+// @Router			/user/me/bookmark-folders/{folderId} [delete]
+func (h *BookmarkHandler) DeleteFolderHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	if err := h.bookmarkService.DeleteFolder(c.Params("folderId"), currentUser.Id); err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse[*struct{}](c, fiber.StatusOK, "Success Delete Bookmark Folder", nil)
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CategoryHandler struct {
+	categoryService service.CategoryService
+	validator       *validator.Validate
+}
+
+func NewCategoryHandler(categoryService service.CategoryService) *CategoryHandler {
+	return &CategoryHandler{
+		categoryService: categoryService,
+		validator:       utils.NewValidator(),
+	}
+}
+
+// @Summary		Create Category
+// @Description	Create a new category (admin only)
+// @Tags			Category
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body		req.CategoryDto	true	"Category Request Payload"
+// @Router			/category [post]
+func (h *CategoryHandler) CreateCategoryHandler(c *fiber.Ctx) error {
+	var payload req.CategoryDto
+
+	if err := utils.ValidateRequestBody(c, h.validator, &payload); err != nil {
+		return err
+	}
+
+	category, err := h.categoryService.CreateCategory(&payload)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusCreated, "Success Create Category", category)
+}
+
+// @Summary		Find All Categories
+// @Description	Get a list of all categories with their post counts
+// @Tags			Category
+// @Accept			json
+// @Produce		json
+// @Router			/category [get]
+func (h *CategoryHandler) FindAllCategoryHandler(c *fiber.Ctx) error {
+	categories, err := h.categoryService.FindAll()
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Find All Categories", categories)
+}
+
+// @Summary		Update Category By Id
+// @Description	Update a category (admin only)
+// @Tags			Category
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string		true	"Category ID"
+// @Param			request	body		req.CategoryDto	true	"Category Request Payload"
+// @Router			/category/{id} [put]
+func (h *CategoryHandler) UpdateCategoryByIdHandler(c *fiber.Ctx) error {
+	id := c.Params("id")
+	var payload req.CategoryDto
+
+	if err := utils.ValidateRequestBody(c, h.validator, &payload); err != nil {
+		return err
+	}
+
+	category, err := h.categoryService.UpdateCategoryById(id, &payload)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Update Category", category)
+}
+
+// @Summary		Delete Category By Id
+// @Description	Delete a category and detach it from every blog (admin only)
+// @Tags			Category
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"Category ID"
+// @Router			/category/{id} [delete]
+func (h *CategoryHandler) DeleteCategoryByIdHandler(c *fiber.Ctx) error {
+	if err := h.categoryService.DeleteCategoryById(c.Params("id")); err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse[*struct{}](c, fiber.StatusOK, "Success Delete Category", nil)
+}
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type CommentHandler struct {
+	commentService service.CommentService
+	validator      *validator.Validate
+}
+
+func NewCommentHandler(commentService service.CommentService) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+		validator:      utils.NewValidator(),
+	}
+}
+
+// @Summary		Create Comment
+// @Description	Comment on a blog, set parentId to reply to another comment
+// @Tags			Comment
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string					true	"Blog ID"
+// @Param			request	body		req.CreateCommentDto	true	"Create Comment Request Payload"
+// @Success		201		{object}	model.Response{data=res.CommentResponse}
+// @Failure		404		{object}	model.ErrorResponse
+// @Router			/blog/{id}/comments [post]
+func (h *CommentHandler) CreateCommentHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var payload req.CreateCommentDto
+
+	if err := utils.ValidateRequestBody(c, h.validator, &payload); err != nil {
+		return err
+	}
+
+	comment, err := h.commentService.CreateComment(c.Params("id"), &payload, currentUser.Id)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusCreated, "Success Create Comment", comment)
+}
+
+// @Summary		Find Blog Comments Paginate
+// @Description	Get the comments of a blog, nested mode paginates top level comments with their replies, flat mode paginates every comment chronologically
+// @Tags			Comment
+// @Accept			json
+// @Produce		json
+// @Param			id		path		string							true	"Blog ID"
+// @Param			request	query		req.CommentPaginationRequest	false	"Pagination Request Payload"
+// @Success		200		{object}	model.Response{data=[]res.CommentResponse,meta=model.MetaPagination}
+// @Failure		404		{object}	model.ErrorResponse
+// @Router			/blog/{id}/comments [get]
+func (h *CommentHandler) FindAllPaginateHandler(c *fiber.Ctx) error {
+	var params req.CommentPaginationRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if err := h.validator.Struct(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = 10
+	}
+
+	meta, comments, err := h.commentService.FindAllPaginate(c.Params("id"), &params, utils.ViewerId(c))
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponsePaginate(c, fiber.StatusOK, "Success Find All Comments Paginate", comments, meta)
+}
+
+// @Summary		Update Comment
+// @Description	Edit your own comment shortly after posting it
+// @Tags			Comment
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id			path		string					true	"Blog ID"
+// @Param			commentId	path		string					true	"Comment ID"
+// @Param			request		body		req.UpdateCommentDto	true	"Update Comment Request Payload"
+// @Success		200			{object}	model.Response{data=res.CommentResponse}
+// @Failure		403			{object}	model.ErrorResponse
+// @Failure		404			{object}	model.ErrorResponse
+// @Router			/blog/{id}/comments/{commentId} [put]
+func (h *CommentHandler) UpdateCommentHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var payload req.UpdateCommentDto
+
+	if err := utils.ValidateRequestBody(c, h.validator, &payload); err != nil {
+		return err
+	}
+
+	comment, err := h.commentService.UpdateComment(
+		c.Params("id"),
+		c.Params("commentId"),
+		&payload,
+		currentUser,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Update Comment", comment)
+}
+
+// @Summary		Delete Comment
+// @Description	Delete a comment and its replies, authors can only do so shortly after posting while admins can always remove comments
+// @Tags			Comment
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id			path		string	true	"Blog ID"
+// @Param			commentId	path		string	true	"Comment ID"
+// @Success		200			{object}	model.Response
+// @Failure		403			{object}	model.ErrorResponse
+// @Failure		404			{object}	model.ErrorResponse
+// @Router			/blog/{id}/comments/{commentId} [delete]
+func (h *CommentHandler) DeleteCommentHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	if err := h.commentService.DeleteComment(
+		c.Params("id"),
+		c.Params("commentId"),
+		currentUser,
+	); err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse[*struct{}](c, fiber.StatusOK, "Success Delete Comment", nil)
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ContributorHandler struct {
+	contributorService service.ContributorService
+	validator          *validator.Validate
+}
+
+func NewContributorHandler(contributorService service.ContributorService) *ContributorHandler {
+	return &ContributorHandler{
+		contributorService: contributorService,
+		validator:          utils.NewValidator(),
+	}
+}
+
+// @Summary		Find Blog Contributors
+// @Description	List the author of a blog followed by its co-authors and editors
+// @Tags			Contributor
+// @Accept			json
+// @Produce		json
+// @Param			id	path		string	true	"Blog ID"
+// @Success		200	{object}	model.Response{data=[]res.ContributorResponse}
+// @Failure		404	{object}	model.ErrorResponse
+// @Router			/blog/{id}/contributors [get]
+func (h *ContributorHandler) FindContributorsHandler(c *fiber.Ctx) error {
+	contributors, err := h.contributorService.FindContributors(c.Params("id"))
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Find Contributors", contributors)
+}
+
+// @Summary		Set Blog Contributor
+// @Description	Add a co-author or editor to a blog or change their role, both can edit the blog and co-authors are listed as its authors. Only the author and admins manage contributors
+// @Tags			Contributor
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string				true	"Blog ID"
+// @Param			userId	path		string				true	"User ID"
+// @Param			request	body		req.ContributorDto	true	"Contributor Request Payload"
+// @Success		200		{object}	model.Response{data=[]res.ContributorResponse}
+// @Failure		403		{object}	model.ErrorResponse
+// @Failure		404		{object}	model.ErrorResponse
+// @Router			/blog/{id}/contributors/{userId} [put]
+func (h *ContributorHandler) SetContributorHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var payload req.ContributorDto
+
+	if err := utils.ValidateRequestBody(c, h.validator, &payload); err != nil {
+		return err
+	}
+
+	contributors, err := h.contributorService.SetContributor(c.Params("id"), c.Params("userId"), &payload, currentUser)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Set Contributor", contributors)
+}
+
+// @Summary		Remove Blog Contributor
+// @Description	Remove a co-author or editor from a blog, contributors can remove themselves
+// @Tags			Contributor
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string	true	"Blog ID"
+// @Param			userId	path		string	true	"User ID"
+// @Success		200		{object}	model.Response
+// @Failure		403		{object}	model.ErrorResponse
+// @Failure		404		{object}	model.ErrorResponse
+// @Router			/blog/{id}/contributors/{userId} [delete]
+func (h *ContributorHandler) RemoveContributorHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	if err := h.contributorService.RemoveContributor(c.Params("id"), c.Params("userId"), currentUser); err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse[*struct{}](c, fiber.StatusOK, "Success Remove Contributor", nil)
+}
+
+// @Summary		Find Author Blogs
+// @Description	Author page, lists every blog a user wrote or contributed to, newest first, optionally only those where they have a role
+// @Tags			Contributor
+// @Accept			json
+// @Produce		json
+// @Param			id		path		string								true	"User ID"
+// @Param			request	query		req.ContributionPaginationRequest	false	"Contribution Pagination Request Payload"
+// @Success		200		{object}	model.Response{data=[]res.FindBlogResponse,meta=model.MetaPagination}
+// @Failure		404		{object}	model.ErrorResponse
+// @Router			/user/{id}/blogs [get]
+func (h *ContributorHandler) FindContributionsHandler(c *fiber.Ctx) error {
+	var params req.ContributionPaginationRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if err := h.validator.Struct(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = 10
+	}
+
+	meta, blogs, err := h.contributorService.FindContributions(c.Params("id"), &params, utils.ViewerId(c))
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponsePaginate(c, fiber.StatusOK, "Success Find Author Blogs", blogs, meta)
+}
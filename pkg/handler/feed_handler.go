@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"learn/fiber/config"
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type FeedHandler struct {
+	feedService service.FeedService
+	validator   *validator.Validate
+}
+
+func NewFeedHandler(feedService service.FeedService) *FeedHandler {
+	return &FeedHandler{
+		feedService: feedService,
+		validator:   utils.NewValidator(),
+	}
+}
+
+// @Summary		RSS Feed
+// @Description	RSS 2.0 feed of the latest blogs, optionally for one author or tag
+// @Tags			Feed
+// @Produce		xml
+// @Param			request	query	req.FeedRequest	false	"Feed Request Payload"
+// @Router			/blog/feed.rss [get]
+func (h *FeedHandler) RssHandler(c *fiber.Ctx) error {
+	return h.render(c, enum.FEED_FORMAT_RSS)
+}
+
+// @Summary		Atom Feed
+// @Description	Atom feed of the latest blogs, optionally for one author or tag
+// @Tags			Feed
+// @Produce		xml
+// @Param			request	query	req.FeedRequest	false	"Feed Request Payload"
+// @Router			/blog/feed.atom [get]
+func (h *FeedHandler) AtomHandler(c *fiber.Ctx) error {
+	return h.render(c, enum.FEED_FORMAT_ATOM)
+}
+
+// @Summary		JSON Feed
+// @Description	JSON Feed 1.1 of the latest blogs, optionally for one author or tag
+// @Tags			Feed
+// @Produce		json
+// @Param			request	query	req.FeedRequest	false	"Feed Request Payload"
+// @Router			/blog/feed.json [get]
+func (h *FeedHandler) JsonHandler(c *fiber.Ctx) error {
+	return h.render(c, enum.FEED_FORMAT_JSON)
+}
+
+func (h *FeedHandler) render(c *fiber.Ctx, format enum.EFeedFormat) error {
+	var params req.FeedRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if err := h.validator.Struct(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = 20
+	}
+
+	feed, err := h.feedService.Render(format, &params, feedLinks(c))
+
+	if err != nil {
+		return err
+	}
+
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	c.Set(fiber.HeaderETag, feed.ETag)
+
+	if !feed.LastModified.IsZero() {
+		c.Set(fiber.HeaderLastModified, feed.LastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if notModified(c, feed.ETag, feed.LastModified) {
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+
+	c.Set(fiber.HeaderContentType, feed.ContentType)
+
+	return c.Status(fiber.StatusOK).Send(feed.Body)
+}
+
+// feedLinks builds absolute links, blogs link to APP_URL when it is set and
+// to this API otherwise.
+func feedLinks(c *fiber.Ctx) service.FeedLinks {
+	title := config.APP_NAME.GetValue()
+
+	if title == "" {
+		title = "Fiber Blog"
+	}
+
+	siteUrl := config.APP_URL.GetValue()
+
+	if siteUrl == "" {
+		siteUrl = c.BaseURL() + "/api/v1"
+	}
+
+	return service.FeedLinks{
+		Title:   title,
+		SiteUrl: siteUrl,
+		FeedUrl: c.BaseURL() + c.OriginalURL(),
+	}
+}
+
+// notModified answers conditional requests, If-None-Match takes precedence
+// over If-Modified-Since as required by RFC 9110.
+func notModified(c *fiber.Ctx, etag string, lastModified time.Time) bool {
+	if noneMatch := c.Get(fiber.HeaderIfNoneMatch); noneMatch != "" {
+		for _, candidate := range strings.Split(noneMatch, ",") {
+			candidate = strings.TrimSpace(candidate)
+
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	modifiedSince := c.Get(fiber.HeaderIfModifiedSince)
+
+	if modifiedSince == "" || lastModified.IsZero() {
+		return false
+	}
+
+	since, err := http.ParseTime(modifiedSince)
+
+	if err != nil {
+		return false
+	}
+
+	return !lastModified.Truncate(time.Second).After(since)
+}
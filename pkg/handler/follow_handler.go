@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type FollowHandler struct {
+	followService service.FollowService
+}
+
+func NewFollowHandler(followService service.FollowService) *FollowHandler {
+	return &FollowHandler{followService: followService}
+}
+
+// @Summary		Follow User
+// @Description	Follow a user to see their blogs in the personal feed, following twice has no effect
+// @Tags			Follow
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"User ID"
+// @Success		200	{object}	model.ResponseEntity[entity.UserResponse]
+// @Failure		400	{object}	model.ResponseError[any]
+// @Failure		404	{object}	model.ResponseError[any]
+// @Router			/user/{id}/follow [post]
+func (h *FollowHandler) FollowHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	user, err := h.followService.Follow(currentUser.Id, c.Params("id"))
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Follow User", user)
+}
+
+// @Summary		Unfollow User
+// @Description	Stop following a user, unfollowing a user that is not followed has no effect
+// @Tags			Follow
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"User ID"
+// @Success		200	{object}	model.ResponseEntity[entity.UserResponse]
+// @Failure		404	{object}	model.ResponseError[any]
+// @Router			/user/{id}/follow [delete]
+func (h *FollowHandler) UnfollowHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	user, err := h.followService.Unfollow(currentUser.Id, c.Params("id"))
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Unfollow User", user)
+}
+
+// @Summary		Find Followers Paginate
+// @Description	List the users following a user, most recent first
+// @Tags			Follow
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string					true	"User ID"
+// @Param			request	query		model.PaginationRequest	false	"Pagination Request Payload"
+// @Success		200		{object}	model.ResponseEntityPagination[[]entity.UserResponse]
+// @Failure		404		{object}	model.ResponseError[any]
+// @Router			/user/{id}/followers [get]
+func (h *FollowHandler) FindFollowersHandler(c *fiber.Ctx) error {
+	params, err := followPagination(c)
+
+	if err != nil {
+		return err
+	}
+
+	meta, users, err := h.followService.FindFollowers(c.Params("id"), params)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponsePaginate(c, fiber.StatusOK, "Success Find Followers Paginate", users, meta)
+}
+
+// @Summary		Find Following Paginate
+// @Description	List the users a user follows, most recent first
+// @Tags			Follow
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string					true	"User ID"
+// @Param			request	query		model.PaginationRequest	false	"Pagination Request Payload"
+// @Success		200		{object}	model.ResponseEntityPagination[[]entity.UserResponse]
+// @Failure		404		{object}	model.ResponseError[any]
+// @Router			/user/{id}/following [get]
+func (h *FollowHandler) FindFollowingHandler(c *fiber.Ctx) error {
+	params, err := followPagination(c)
+
+	if err != nil {
+		return err
+	}
+
+	meta, users, err := h.followService.FindFollowing(c.Params("id"), params)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponsePaginate(c, fiber.StatusOK, "Success Find Following Paginate", users, meta)
+}
+
+func followPagination(c *fiber.Ctx) (*model.PaginationRequest, error) {
+	var params model.PaginationRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+
+	if params.Limit <= 0 || params.Limit > 100 {
+		params.Limit = 10
+	}
+
+	return &params, nil
+}
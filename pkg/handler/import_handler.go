@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ImportHandler struct {
+	wordPressImportService service.WordPressImportService
+}
+
+func NewImportHandler(wordPressImportService service.WordPressImportService) *ImportHandler {
+	return &ImportHandler{
+		wordPressImportService: wordPressImportService,
+	}
+}
+
+// @Summary		Import WordPress
+// @Description	Import a WordPress export (WXR). Authors become users with a random password that an admin replaces with PUT /user/{id}/password, published posts become blogs with their categories, tags and approved comments, and uploaded media is copied to this server. Content that already exists is skipped, the report lists every skipped or failed item. Exports larger than BODY_LIMIT_MB are rejected, import them with the import wordpress command instead
+// @Tags			Admin
+// @Accept			multipart/form-data
+// @Produce		json
+// @Security		BearerAuth
+// @Param			file		formData	file	true	"WordPress export (.xml)"
+// @Param			skipMedia	formData	bool	false	"Keep links to the WordPress uploads and drop featured images instead of copying them"
+// @Success		200			{object}	model.Response{data=res.WordPressImportResponse}
+// @Failure		400			{object}	model.ErrorResponse
+// @Failure		403			{object}	model.ErrorResponse
+// @Router			/admin/import/wordpress [post]
+func (h *ImportHandler) WordPressImportHandler(c *fiber.Ctx) error {
+	file, err := c.FormFile("file")
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "File is required")
+	}
+
+	var options req.WordPressImportRequest
+
+	if err := c.BodyParser(&options); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	content, err := file.Open()
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	defer content.Close()
+
+	report, err := h.wordPressImportService.Import(content, &options)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Import WordPress", report)
+}
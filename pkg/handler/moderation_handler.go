@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ModerationHandler struct {
+	moderationService service.ModerationService
+	validator         *validator.Validate
+}
+
+func NewModerationHandler(moderationService service.ModerationService) *ModerationHandler {
+	return &ModerationHandler{
+		moderationService: moderationService,
+		validator:         utils.NewValidator(),
+	}
+}
+
+// @Summary		Report Blog
+// @Description	Report a blog to the moderators, a blog is hidden automatically once it collects enough pending reports
+// @Tags			Moderation
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string			true	"Blog ID"
+// @Param			request	body		req.ReportDto	true	"Report Request Payload"
+// @Success		201		{object}	model.ResponseEntity[res.ReportResponse]
+// @Failure		404		{object}	model.ResponseError[any]
+// @Failure		409		{object}	model.ResponseError[any]
+// @Router			/blog/{id}/report [post]
+func (h *ModerationHandler) ReportBlogHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var payload req.ReportDto
+
+	if err := utils.ValidateRequestBody(c, h.validator, &payload); err != nil {
+		return err
+	}
+
+	report, err := h.moderationService.ReportBlog(c.Params("id"), &payload, currentUser.Id)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusCreated, "Success Report Blog", report)
+}
+
+// @Summary		Report Comment
+// @Description	Report a comment to the moderators, a comment is hidden automatically once it collects enough pending reports
+// @Tags			Moderation
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id			path		string			true	"Blog ID"
+// @Param			commentId	path		string			true	"Comment ID"
+// @Param			request		body		req.ReportDto	true	"Report Request Payload"
+// @Success		201			{object}	model.ResponseEntity[res.ReportResponse]
+// @Failure		404			{object}	model.ResponseError[any]
+// @Failure		409			{object}	model.ResponseError[any]
+// @Router			/blog/{id}/comments/{commentId}/report [post]
+func (h *ModerationHandler) ReportCommentHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var payload req.ReportDto
+
+	if err := utils.ValidateRequestBody(c, h.validator, &payload); err != nil {
+		return err
+	}
+
+	report, err := h.moderationService.ReportComment(c.Params("id"), c.Params("commentId"), &payload, currentUser.Id)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusCreated, "Success Report Comment", report)
+}
+
+// @Summary		Moderation Queue
+// @Description	Reported blogs and comments with pending reports, the most reported first. Moderators and admins only
+// @Tags			Moderation
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	query		req.ModerationQueueRequest	false	"Moderation Queue Request Payload"
+// @Success		200		{object}	model.ResponseEntityPagination[[]res.ModerationQueueItem]
+// @Failure		403		{object}	model.ResponseError[any]
+// @Router			/moderation/queue [get]
+func (h *ModerationHandler) FindQueueHandler(c *fiber.Ctx) error {
+	var params req.ModerationQueueRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if err := h.validator.Struct(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = 10
+	}
+
+	meta, items, err := h.moderationService.FindQueue(&params)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponsePaginate(c, fiber.StatusOK, "Success Find Moderation Queue", items, meta)
+}
+
+// @Summary		Hide Reported Content
+// @Description	Hide a blog or comment from everyone but its author and mark its reports as actioned
+// @Tags			Moderation
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			targetType	path		string	true	"Target type"	Enums(blog, comment)
+// @Param			targetId	path		string	true	"Blog or comment ID"
+// @Success		200			{object}	model.ResponseEntity[any]
+// @Failure		403			{object}	model.ResponseError[any]
+// @Failure		404			{object}	model.ResponseError[any]
+// @Router			/moderation/{targetType}/{targetId}/hide [post]
+func (h *ModerationHandler) HideHandler(c *fiber.Ctx) error {
+	return h.resolve(c, h.moderationService.Hide, "Success Hide Content")
+}
+
+// @Summary		Restore Hidden Content
+// @Description	Make a hidden blog or comment visible again and dismiss its pending reports
+// @Tags			Moderation
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			targetType	path		string	true	"Target type"	Enums(blog, comment)
+// @Param			targetId	path		string	true	"Blog or comment ID"
+// @Success		200			{object}	model.ResponseEntity[any]
+// @Failure		403			{object}	model.ResponseError[any]
+// @Failure		404			{object}	model.ResponseError[any]
+// @Router			/moderation/{targetType}/{targetId}/restore [post]
+func (h *ModerationHandler) RestoreHandler(c *fiber.Ctx) error {
+	return h.resolve(c, h.moderationService.Restore, "Success Restore Content")
+}
+
+// @Summary		Dismiss Reports
+// @Description	Dismiss the pending reports of a blog or comment without changing whether it is hidden
+// @Tags			Moderation
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			targetType	path		string	true	"Target type"	Enums(blog, comment)
+// @Param			targetId	path		string	true	"Blog or comment ID"
+// @Success		200			{object}	model.ResponseEntity[any]
+// @Failure		403			{object}	model.ResponseError[any]
+// @Failure		404			{object}	model.ResponseError[any]
+// @Router			/moderation/{targetType}/{targetId}/dismiss [post]
+func (h *ModerationHandler) DismissHandler(c *fiber.Ctx) error {
+	return h.resolve(c, h.moderationService.Dismiss, "Success Dismiss Reports")
+}
+
+func (h *ModerationHandler) resolve(c *fiber.Ctx, action func(targetType, targetId string, user model.JwtPayload) error, message string) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	if err := action(c.Params("targetType"), c.Params("targetId"), currentUser); err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse[*struct{}](c, fiber.StatusOK, message, nil)
+}
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReactionHandler struct {
+	reactionService service.ReactionService
+	validator       *validator.Validate
+}
+
+func NewReactionHandler(reactionService service.ReactionService) *ReactionHandler {
+	return &ReactionHandler{
+		reactionService: reactionService,
+		validator:       utils.NewValidator(),
+	}
+}
+
+// @Summary		Toggle Blog Reaction
+// @Description	React to a blog, sending the same reaction again removes it and sending another one replaces it
+// @Tags			Reaction
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string			true	"Blog ID"
+// @Param			request	body		req.ReactionDto	true	"Reaction Request Payload"
+// @Success		200		{object}	model.Response{data=res.ReactionSummary}
+// @Failure		404		{object}	model.ErrorResponse
+// @Router			/blog/{id}/reactions [post]
+func (h *ReactionHandler) ToggleBlogReactionHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var payload req.ReactionDto
+
+	if err := utils.ValidateRequestBody(c, h.validator, &payload); err != nil {
+		return err
+	}
+
+	summary, err := h.reactionService.ToggleBlogReaction(c.Params("id"), &payload, currentUser.Id)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Toggle Blog Reaction", summary)
+}
+
+// @Summary		Toggle Comment Reaction
+// @Description	React to a comment, sending the same reaction again removes it and sending another one replaces it
+// @Tags			Reaction
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id			path		string			true	"Blog ID"
+// @Param			commentId	path		string			true	"Comment ID"
+// @Param			request		body		req.ReactionDto	true	"Reaction Request Payload"
+// @Success		200			{object}	model.Response{data=res.ReactionSummary}
+// @Failure		404			{object}	model.ErrorResponse
+// @Router			/blog/{id}/comments/{commentId}/reactions [post]
+func (h *ReactionHandler) ToggleCommentReactionHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var payload req.ReactionDto
+
+	if err := utils.ValidateRequestBody(c, h.validator, &payload); err != nil {
+		return err
+	}
+
+	summary, err := h.reactionService.ToggleCommentReaction(
+		c.Params("id"),
+		c.Params("commentId"),
+		&payload,
+		currentUser.Id,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Toggle Comment Reaction", summary)
+}
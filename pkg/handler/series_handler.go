@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type SeriesHandler struct {
+	seriesService service.SeriesService
+	validator     *validator.Validate
+}
+
+func NewSeriesHandler(seriesService service.SeriesService) *SeriesHandler {
+	return &SeriesHandler{
+		seriesService: seriesService,
+		validator:     utils.NewValidator(),
+	}
+}
+
+// @Summary		Create Series
+// @Description	Group blogs of the current user into a series, blogIds are in reading order and a blog belongs to at most one series
+// @Tags			Series
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body		req.SeriesDto	true	"Series Request Payload"
+// @Success		201		{object}	model.Response{data=res.SeriesDetailResponse}
+// @Failure		400		{object}	model.ErrorResponse{data=[]model.FieldError}
+// @Failure		409		{object}	model.ErrorResponse{data=[]model.FieldError}
+// @Router			/series [post]
+func (h *SeriesHandler) CreateSeriesHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var payload req.SeriesDto
+
+	if err := utils.ValidateRequestBody(c, h.validator, &payload); err != nil {
+		return err
+	}
+
+	series, err := h.seriesService.CreateSeries(&payload, currentUser.Id)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusCreated, "Success Create Series", series)
+}
+
+// @Summary		Find Series
+// @Description	Get a series with its posts in reading order
+// @Tags			Series
+// @Accept			json
+// @Produce		json
+// @Param			id	path		string	true	"Series ID"
+// @Success		200	{object}	model.Response{data=res.SeriesDetailResponse}
+// @Failure		404	{object}	model.ErrorResponse
+// @Router			/series/{id} [get]
+func (h *SeriesHandler) FindSeriesByIdHandler(c *fiber.Ctx) error {
+	series, err := h.seriesService.FindById(c.Params("id"), utils.ViewerId(c))
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Find Series", series)
+}
+
+// @Summary		Find My Series
+// @Description	List the series of the current user, newest first
+// @Tags			Series
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Success		200	{object}	model.Response{data=[]res.SeriesResponse}
+// @Failure		401	{object}	model.ErrorResponse
+// @Router			/user/me/series [get]
+func (h *SeriesHandler) FindMySeriesHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	series, err := h.seriesService.FindByUser(currentUser.Id)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Find Series", series)
+}
+
+// @Summary		Update Series
+// @Description	Update a series of the current user, sending blogIds replaces its posts and their order while leaving it out keeps them
+// @Tags			Series
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string			true	"Series ID"
+// @Param			request	body		req.SeriesDto	true	"Series Request Payload"
+// @Success		200		{object}	model.Response{data=res.SeriesDetailResponse}
+// @Failure		403		{object}	model.ErrorResponse
+// @Failure		404		{object}	model.ErrorResponse
+// @Failure		409		{object}	model.ErrorResponse{data=[]model.FieldError}
+// @Router			/series/{id} [put]
+func (h *SeriesHandler) UpdateSeriesHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	var payload req.SeriesDto
+
+	if err := utils.ValidateRequestBody(c, h.validator, &payload); err != nil {
+		return err
+	}
+
+	series, err := h.seriesService.UpdateSeries(c.Params("id"), &payload, currentUser)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Update Series", series)
+}
+
+// @Summary		Delete Series
+// @Description	Delete a series of the current user, its posts are kept
+// @Tags			Series
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"Series ID"
+// @Success		200	{object}	model.Response
+// @Failure		403	{object}	model.ErrorResponse
+// @Failure		404	{object}	model.ErrorResponse
+// @Router			/series/{id} [delete]
+func (h *SeriesHandler) DeleteSeriesHandler(c *fiber.Ctx) error {
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	if err := h.seriesService.DeleteSeries(c.Params("id"), currentUser); err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse[*struct{}](c, fiber.StatusOK, "Success Delete Series", nil)
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TagHandler struct {
+	tagService service.TagService
+	validator  *validator.Validate
+}
+
+func NewTagHandler(tagService service.TagService) *TagHandler {
+	return &TagHandler{
+		tagService: tagService,
+		validator:  utils.NewValidator(),
+	}
+}
+
+// @Summary		Create Tag
+// @Description	Create a new tag (admin only)
+// @Tags			Tag
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	body		req.TagDto	true	"Tag Request Payload"
+// @Router			/tag [post]
+func (t *TagHandler) CreateTagHandler(c *fiber.Ctx) error {
+	var payload req.TagDto
+
+	if err := utils.ValidateRequestBody(c, t.validator, &payload); err != nil {
+		return err
+	}
+
+	tag, err := t.tagService.CreateTag(&payload)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusCreated, "Success Create Tag", tag)
+}
+
+// @Summary		Find All Tags
+// @Description	Get a list of all tags with their post counts
+// @Tags			Tag
+// @Accept			json
+// @Produce		json
+// @Router			/tag [get]
+func (t *TagHandler) FindAllTagHandler(c *fiber.Ctx) error {
+	tags, err := t.tagService.FindAll()
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Find All Tags", tags)
+}
+
+// @Summary		Update Tag By Id
+// @Description	Rename a tag (admin only)
+// @Tags			Tag
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id		path		string		true	"Tag ID"
+// @Param			request	body		req.TagDto	true	"Tag Request Payload"
+// @Router			/tag/{id} [put]
+func (t *TagHandler) UpdateTagByIdHandler(c *fiber.Ctx) error {
+	id := c.Params("id")
+	var payload req.TagDto
+
+	if err := utils.ValidateRequestBody(c, t.validator, &payload); err != nil {
+		return err
+	}
+
+	tag, err := t.tagService.UpdateTagById(id, &payload)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Update Tag", tag)
+}
+
+// @Summary		Delete Tag By Id
+// @Description	Delete a tag and detach it from every blog (admin only)
+// @Tags			Tag
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"Tag ID"
+// @Router			/tag/{id} [delete]
+func (t *TagHandler) DeleteTagByIdHandler(c *fiber.Ctx) error {
+	if err := t.tagService.DeleteTagById(c.Params("id")); err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse[*struct{}](c, fiber.StatusOK, "Success Delete Tag", nil)
+}
@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type TrashHandler struct {
+	trashService service.TrashService
+}
+
+func NewTrashHandler(trashService service.TrashService) *TrashHandler {
+	return &TrashHandler{
+		trashService: trashService,
+	}
+}
+
+// @Summary		Find Deleted Users
+// @Description	List deleted users, the most recently deleted first, with the number of blogs deleted along with them and when they are purged. Admins only
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	query		model.PaginationRequest	false	"Pagination Request Payload"
+// @Success		200		{object}	model.ResponseEntityPagination[[]res.TrashUserResponse]
+// @Failure		403		{object}	model.ResponseError[any]
+// @Router			/admin/trash/users [get]
+func (h *TrashHandler) FindUsersHandler(c *fiber.Ctx) error {
+	params, err := trashPagination(c)
+
+	if err != nil {
+		return err
+	}
+
+	meta, users, err := h.trashService.FindUsers(params)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponsePaginate(c, fiber.StatusOK, "Success Find Deleted Users", users, meta)
+}
+
+// @Summary		Restore User
+// @Description	Restore a deleted user together with the blogs that were deleted along with it. Admins only
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"User ID"
+// @Success		200	{object}	model.ResponseEntity[any]
+// @Failure		403	{object}	model.ResponseError[any]
+// @Failure		404	{object}	model.ResponseError[any]
+// @Router			/admin/trash/users/{id}/restore [post]
+func (h *TrashHandler) RestoreUserHandler(c *fiber.Ctx) error {
+	if err := h.trashService.RestoreUser(c.Params("id")); err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse[*struct{}](c, fiber.StatusOK, "Success Restore User", nil)
+}
+
+// @Summary		Purge User
+// @Description	Permanently remove a deleted user with its blogs, comments, reactions, follows, bookmarks and series. Admins only
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"User ID"
+// @Success		200	{object}	model.ResponseEntity[any]
+// @Failure		403	{object}	model.ResponseError[any]
+// @Failure		404	{object}	model.ResponseError[any]
+// @Router			/admin/trash/users/{id} [delete]
+func (h *TrashHandler) PurgeUserHandler(c *fiber.Ctx) error {
+	if err := h.trashService.PurgeUser(c.Params("id")); err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse[*struct{}](c, fiber.StatusOK, "Success Purge User", nil)
+}
+
+// @Summary		Find Deleted Blogs
+// @Description	List deleted blogs, the most recently deleted first, with when they are purged. Admins only
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	query		model.PaginationRequest	false	"Pagination Request Payload"
+// @Success		200		{object}	model.ResponseEntityPagination[[]res.TrashBlogResponse]
+// @Failure		403		{object}	model.ResponseError[any]
+// @Router			/admin/trash/blogs [get]
+func (h *TrashHandler) FindBlogsHandler(c *fiber.Ctx) error {
+	params, err := trashPagination(c)
+
+	if err != nil {
+		return err
+	}
+
+	meta, blogs, err := h.trashService.FindBlogs(params)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponsePaginate(c, fiber.StatusOK, "Success Find Deleted Blogs", blogs, meta)
+}
+
+// @Summary		Restore Blog
+// @Description	Restore a deleted blog, a blog of a deleted user comes back by restoring the user. Admins only
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"Blog ID"
+// @Success		200	{object}	model.ResponseEntity[any]
+// @Failure		403	{object}	model.ResponseError[any]
+// @Failure		404	{object}	model.ResponseError[any]
+// @Failure		409	{object}	model.ResponseError[[]model.FieldError]
+// @Router			/admin/trash/blogs/{id}/restore [post]
+func (h *TrashHandler) RestoreBlogHandler(c *fiber.Ctx) error {
+	if err := h.trashService.RestoreBlog(c.Params("id")); err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse[*struct{}](c, fiber.StatusOK, "Success Restore Blog", nil)
+}
+
+// @Summary		Purge Blog
+// @Description	Permanently remove a deleted blog with its comments, reactions, revisions, translations and views. Admins only
+// @Tags			Admin
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			id	path		string	true	"Blog ID"
+// @Success		200	{object}	model.ResponseEntity[any]
+// @Failure		403	{object}	model.ResponseError[any]
+// @Failure		404	{object}	model.ResponseError[any]
+// @Router			/admin/trash/blogs/{id} [delete]
+func (h *TrashHandler) PurgeBlogHandler(c *fiber.Ctx) error {
+	if err := h.trashService.PurgeBlog(c.Params("id")); err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse[*struct{}](c, fiber.StatusOK, "Success Purge Blog", nil)
+}
+
+func trashPagination(c *fiber.Ctx) (*model.PaginationRequest, error) {
+	var params model.PaginationRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = 10
+	}
+
+	return &params, nil
+}
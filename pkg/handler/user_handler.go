@@ -19,7 +19,7 @@ type UserHandler struct {
 func NewUserHandler(userService service.UserService) *UserHandler {
 	return &UserHandler{
 		userService: userService,
-		validator:   validator.New(),
+		validator:   utils.NewValidator(),
 	}
 }
 
@@ -39,7 +39,7 @@ func (u *UserHandler) RegisterUserHandler(c *fiber.Ctx) error {
 	}
 
 	if !utils.ValidatePassword(payload.Password) {
-		return fiber.NewError(fiber.StatusBadRequest, "Password must be at least 6 characters long, contain at least one uppercase letter, one number, and one special character")
+		return fiber.NewError(fiber.StatusBadRequest, utils.PasswordRules)
 	}
 
 	user, err := u.userService.RegisterUser(&payload)
@@ -120,7 +120,7 @@ func (u *UserHandler) FindAllHandler(c *fiber.Ctx) error {
 }
 
 // @Summary		    Find All Users Paginate
-// @Description	Get a list of all users with pagination
+// @Description	Get a list of all users with pagination. Sort with sort=-createdAt,username on email, username, createdAt or updatedAt. Filter with filter[field]=value or filter[field][op]=value on id, email, username (eq, ne, like, in), role (eq, ne, in), createdAt and updatedAt (eq, ne, gt, gte, lt, lte)
 // @Tags			       user
 // @Accept			     json
 // @Produce		    json
@@ -136,6 +136,14 @@ func (u *UserHandler) FindAllPaginateHandler(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
+	filters, err := utils.ParseFilters(c)
+
+	if err != nil {
+		return err
+	}
+
+	params.Filters = filters
+
 	if params.Page <= 0 {
 		params.Page = 1
 	}
@@ -153,6 +161,41 @@ func (u *UserHandler) FindAllPaginateHandler(c *fiber.Ctx) error {
 	return utils.SuccessResponsePaginate(c, int(fiber.StatusOK), "Success Find All User Paginate", users, meta)
 }
 
+// @Summary		Find All Users Cursor
+// @Description	Get users newest first with keyset pagination, pass nextCursor or prevCursor from meta back as cursor to move between pages
+// @Tags			user
+// @Accept			json
+// @Produce		json
+// @Security		BearerAuth
+// @Param			request	query		model.CursorPaginationRequest	true	"Cursor Pagination Request Payload"
+// @Success		200		{object}	model.ResponseEntityCursor[[]entity.UserResponse]
+// @Failure		400		{object}	model.ResponseError[any]
+// @Failure		401		{object}	model.ResponseError[any]
+// @Router			/user/cursor [get]
+func (u *UserHandler) FindAllCursorHandler(c *fiber.Ctx) error {
+	var params model.CursorPaginationRequest
+
+	if err := c.QueryParser(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if err := u.validator.Struct(&params); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = 5
+	}
+
+	meta, users, err := u.userService.FindAllCursor(&params)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponseCursor(c, fiber.StatusOK, "Success Find All User Cursor", users, meta)
+}
+
 // @Summary		    Find User By Id
 // @Description	Get user details by ID
 // @Tags			       user
@@ -177,7 +220,7 @@ func (u *UserHandler) FindByIdHandler(c *fiber.Ctx) error {
 }
 
 // @Summary		    Update User By Id
-// @Description	Update user details by ID
+// @Description	Update user details by ID, only an admin can change the role
 // @Tags			       user
 // @Accept			     json
 // @Produce		    json
@@ -185,6 +228,7 @@ func (u *UserHandler) FindByIdHandler(c *fiber.Ctx) error {
 // @Param			request	body	entity.UserUpdateRequest	true		"Update User Request Payload"
 // @Param			id		path	string						true		"User ID"
 // @Success		 		 		200							{object}	model.ResponseEntity[entity.UserResponse]
+// @Failure		 	 		403		{object}	model.ResponseError[any]
 // @Router			     /user/{id} [put]
 func (u *UserHandler) UpdateUserByIdHandler(c *fiber.Ctx) error {
 	id := c.Params("id")
@@ -194,7 +238,13 @@ func (u *UserHandler) UpdateUserByIdHandler(c *fiber.Ctx) error {
 		return err
 	}
 
-	user, err := u.userService.UpdateUserById(id, &payload)
+	currentUser, err := utils.CurrentUser(c)
+
+	if err != nil {
+		return err
+	}
+
+	user, err := u.userService.UpdateUserById(id, &payload, currentUser)
 
 	if err != nil {
 		return err
@@ -203,8 +253,41 @@ func (u *UserHandler) UpdateUserByIdHandler(c *fiber.Ctx) error {
 	return utils.SuccessResponse(c, fiber.StatusOK, fmt.Sprintf("Success get user with id %s", user.Id), user)
 }
 
+// @Summary		    Set User Password
+// @Description	Set the password of a user and clear passwordResetRequired, this is how accounts created by a WordPress import are unlocked
+// @Tags			       user
+// @Accept			     json
+// @Produce		    json
+// @Security		        BearerAuth
+// @Param			request	body	entity.UserPasswordRequest	true		"Password Request Payload"
+// @Param			id		path	string						true		"User ID"
+// @Success		 		 		200							{object}	model.ResponseEntity[entity.UserResponse]
+// @Failure		 		 		400							{object}	model.ResponseError[any]
+// @Failure		 		 		404							{object}	model.ResponseError[any]
+// @Router			     /user/{id}/password [put]
+func (u *UserHandler) SetPasswordHandler(c *fiber.Ctx) error {
+	id := c.Params("id")
+	var payload entity.UserPasswordRequest
+
+	if err := utils.ValidateRequestBody(c, u.validator, &payload); err != nil {
+		return err
+	}
+
+	if !utils.ValidatePassword(payload.Password) {
+		return fiber.NewError(fiber.StatusBadRequest, utils.PasswordRules)
+	}
+
+	user, err := u.userService.SetPasswordById(id, &payload)
+
+	if err != nil {
+		return err
+	}
+
+	return utils.SuccessResponse(c, fiber.StatusOK, "Success Set User Password", user)
+}
+
 // @Summary		    Delete User By Id
-// @Description	Delete user details by ID
+// @Description	Move a user and its blogs to the trash, an admin can restore them until they are purged
 // @Tags			       user
 // @Accept			     json
 // @Produce		    json
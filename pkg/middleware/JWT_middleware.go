@@ -14,7 +14,11 @@ func JWTMidleware(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusUnauthorized, "Unauthorized, no token provided")
 	}
 
-	tokenStr := authHeader[len("Bearer "):]
+	tokenStr, err := utils.ParseBearerToken(authHeader)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+	}
 
 	payload, err := utils.ValidateToken(tokenStr, config.JWT_SECRET_ACCESS_TOKEN.GetValue())
 
@@ -22,7 +26,26 @@ func JWTMidleware(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusUnauthorized, err.Error())
 	}
 
-	c.Locals("payload", payload)
+	utils.SetPayload(c, payload)
+
+	return c.Next()
+}
+
+// OptionalJWT behaves like JWTMidleware when a valid bearer token is sent,
+// but lets anonymous requests through instead of rejecting them. Malformed
+// or expired tokens are treated as anonymous too.
+func OptionalJWT(c *fiber.Ctx) error {
+	tokenStr, err := utils.ParseBearerToken(c.Get("Authorization"))
+
+	if err != nil {
+		return c.Next()
+	}
+
+	payload, err := utils.ValidateToken(tokenStr, config.JWT_SECRET_ACCESS_TOKEN.GetValue())
+
+	if err == nil {
+		utils.SetPayload(c, payload)
+	}
 
 	return c.Next()
 }
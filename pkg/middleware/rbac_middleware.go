@@ -2,7 +2,7 @@ package middleware
 
 import (
 	"learn/fiber/pkg/enum"
-	"learn/fiber/pkg/model"
+	"learn/fiber/utils"
 	"slices"
 
 	"github.com/gofiber/fiber/v2"
@@ -10,9 +10,13 @@ import (
 
 func RoleMiddleware(requiredRole ...enum.ERole) fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		role := c.Locals("payload").(model.JwtPayload).Role
+		payload, err := utils.CurrentUser(c)
 
-		if slices.Contains(requiredRole, role) {
+		if err != nil {
+			return err
+		}
+
+		if slices.Contains(requiredRole, payload.Role) {
 			return c.Next()
 		}
 
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ValidateId rejects a request whose route param is not an id with the given
+// prefix, such as a comment id passed where a blog id belongs, before it
+// reaches the handler.
+func ValidateId(param string, prefix enum.EIdPrefix) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !entity.IsId(c.Params(param), prefix) {
+			return utils.NewValidationError(fiber.StatusBadRequest, model.FieldError{
+				Field:   param,
+				Message: "must be a " + string(prefix) + " id",
+			})
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,367 @@
+package migration
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Dir is where Create writes new migrations, relative to the module root.
+const Dir = "pkg/migration/sql"
+
+// lockKey identifies the advisory lock held while migrating, so instances
+// starting together apply every migration exactly once.
+const lockKey int64 = 4_607_321_093
+
+//go:embed sql/*.sql
+var files embed.FS
+
+var (
+	fileName = regexp.MustCompile(`^(\d{14})_([a-z0-9_]+)\.(up|down)\.sql$`)
+	nameSep  = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+type Migration struct {
+	Version string
+	Name    string
+	Up      string
+	Down    string
+}
+
+type Status struct {
+	Version   string
+	Name      string
+	AppliedAt *time.Time
+
+	// Missing marks a version applied to the database that this build does
+	// not know about, it can not be rolled back from here.
+	Missing bool
+}
+
+type appliedMigration struct {
+	Version   string
+	Name      string
+	AppliedAt time.Time
+}
+
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	migrations, err := load(files)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Up applies every pending migration in version order, each one in its own
+// transaction, and returns the ones it applied.
+func (m *Migrator) Up() ([]Migration, error) {
+	var ran []Migration
+
+	err := m.locked(func(conn *gorm.DB) error {
+		applied, err := appliedVersions(conn)
+
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+
+			if err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.Up).Error; err != nil {
+					return err
+				}
+
+				return tx.Exec(
+					"INSERT INTO schema_migrations (version, name) VALUES (?, ?)",
+					migration.Version,
+					migration.Name,
+				).Error
+			}); err != nil {
+				return fmt.Errorf("migration %s_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			ran = append(ran, migration)
+		}
+
+		return nil
+	})
+
+	return ran, err
+}
+
+// Down rolls back the latest steps applied migrations, newest first, and
+// returns the ones it rolled back.
+func (m *Migrator) Down(steps int) ([]Migration, error) {
+	var ran []Migration
+
+	err := m.locked(func(conn *gorm.DB) error {
+		var applied []appliedMigration
+
+		if err := conn.Raw(`
+        SELECT version, name, applied_at FROM schema_migrations
+        ORDER BY version DESC
+        LIMIT ?
+    `, steps).Scan(&applied).Error; err != nil {
+			return err
+		}
+
+		for _, row := range applied {
+			migration, ok := m.find(row.Version)
+
+			if !ok {
+				return fmt.Errorf("migration %s_%s is applied but not part of this build", row.Version, row.Name)
+			}
+
+			if err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.Down).Error; err != nil {
+					return err
+				}
+
+				return tx.Exec("DELETE FROM schema_migrations WHERE version = ?", migration.Version).Error
+			}); err != nil {
+				return fmt.Errorf("migration %s_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			ran = append(ran, migration)
+		}
+
+		return nil
+	})
+
+	return ran, err
+}
+
+// Status lists every known migration with the time it was applied, nil when
+// it is pending, followed by applied versions missing from this build.
+func (m *Migrator) Status() ([]Status, error) {
+	applied := map[string]appliedMigration{}
+
+	// Status only reads, a database without the table has applied nothing.
+	if m.db.Migrator().HasTable("schema_migrations") {
+		var err error
+
+		if applied, err = appliedVersions(m.db); err != nil {
+			return nil, err
+		}
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+
+	for _, migration := range m.migrations {
+		status := Status{Version: migration.Version, Name: migration.Name}
+
+		if row, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &row.AppliedAt
+			delete(applied, migration.Version)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	missing := make([]Status, 0, len(applied))
+
+	for _, row := range applied {
+		appliedAt := row.AppliedAt
+		missing = append(missing, Status{
+			Version:   row.Version,
+			Name:      row.Name,
+			AppliedAt: &appliedAt,
+			Missing:   true,
+		})
+	}
+
+	sort.Slice(missing, func(i, j int) bool {
+		return missing[i].Version < missing[j].Version
+	})
+
+	return append(statuses, missing...), nil
+}
+
+// Pending counts the migrations not applied yet.
+func (m *Migrator) Pending() (int, error) {
+	statuses, err := m.Status()
+
+	if err != nil {
+		return 0, err
+	}
+
+	pending := 0
+
+	for _, status := range statuses {
+		if status.AppliedAt == nil {
+			pending++
+		}
+	}
+
+	return pending, nil
+}
+
+func (m *Migrator) find(version string) (Migration, bool) {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+
+	return Migration{}, false
+}
+
+// locked runs fc on a single connection holding the migration lock, other
+// instances wait for it before reading schema_migrations.
+func (m *Migrator) locked(fc func(conn *gorm.DB) error) error {
+	return m.db.Connection(func(tx *gorm.DB) error {
+		conn := tx.Session(&gorm.Session{})
+
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", lockKey).Error; err != nil {
+			return err
+		}
+
+		defer conn.Exec("SELECT pg_advisory_unlock(?)", lockKey)
+
+		if err := createTable(conn); err != nil {
+			return err
+		}
+
+		return fc(conn)
+	})
+}
+
+// Create writes an empty up and down migration named after the current time
+// into dir and returns their paths.
+func Create(dir, name string) ([]string, error) {
+	name = strings.Trim(nameSep.ReplaceAllString(strings.ToLower(name), "_"), "_")
+
+	if name == "" {
+		return nil, errors.New("migration name must contain letters or digits")
+	}
+
+	version := time.Now().UTC().Format("20060102150405")
+	paths := make([]string, 0, 2)
+
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(dir, fmt.Sprintf("%s_%s.%s.sql", version, name, direction))
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+
+		if err != nil {
+			return paths, err
+		}
+
+		_, err = fmt.Fprintf(file, "-- %s %s\n", strings.ReplaceAll(name, "_", " "), direction)
+
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			return paths, err
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+func createTable(db *gorm.DB) error {
+	return db.Exec(`
+        CREATE TABLE IF NOT EXISTS schema_migrations (
+            version varchar(14) PRIMARY KEY,
+            name varchar(255) NOT NULL,
+            applied_at timestamptz NOT NULL DEFAULT NOW()
+        )
+    `).Error
+}
+
+func appliedVersions(db *gorm.DB) (map[string]appliedMigration, error) {
+	var rows []appliedMigration
+
+	if err := db.Raw("SELECT version, name, applied_at FROM schema_migrations").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	applied := make(map[string]appliedMigration, len(rows))
+
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+
+	return applied, nil
+}
+
+// load pairs the up and down files of every version, both are required.
+func load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, "sql")
+
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[string]*Migration{}
+
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q", entry.Name())
+		}
+
+		content, err := fs.ReadFile(fsys, "sql/"+entry.Name())
+
+		if err != nil {
+			return nil, err
+		}
+
+		version, name, direction := match[1], match[2], match[3]
+		migration, ok := byVersion[version]
+
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+
+		if migration.Name != name {
+			return nil, fmt.Errorf("migration %s has files named %s and %s", version, migration.Name, name)
+		}
+
+		if direction == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %s_%s needs both an up and a down file", migration.Version, migration.Name)
+		}
+
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
@@ -0,0 +1,6 @@
+-- The baseline creates every table, rolling it back would drop all the data,
+-- so it refuses instead. Drop the tables by hand to start over.
+DO $$
+BEGIN
+    RAISE EXCEPTION 'the baseline migration can not be rolled back';
+END $$;
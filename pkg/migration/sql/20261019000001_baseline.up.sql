@@ -0,0 +1,318 @@
+-- The schema AutoMigrate used to create on startup. Every statement is
+-- guarded so databases created that way are adopted as they are.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Tables created before entity.Base may still have the auto-increment
+-- default gorm.Model left on id, or miss their primary key.
+DO $$
+DECLARE
+    t text;
+BEGIN
+    FOREACH t IN ARRAY ARRAY[
+        'users', 'blogs', 'tags', 'categories', 'comments',
+        'reactions', 'reports', 'series', 'bookmark_folders'
+    ] LOOP
+        IF to_regclass(t) IS NULL THEN
+            CONTINUE;
+        END IF;
+
+        IF EXISTS (
+            SELECT 1 FROM information_schema.columns
+            WHERE table_schema = CURRENT_SCHEMA() AND table_name = t AND column_name = 'id'
+                AND column_default LIKE 'nextval(%'
+        ) THEN
+            EXECUTE format('ALTER TABLE %I ALTER COLUMN id DROP DEFAULT', t);
+            EXECUTE format('DROP SEQUENCE IF EXISTS %I', t || '_id_seq');
+        END IF;
+
+        IF NOT EXISTS (
+            SELECT 1 FROM information_schema.table_constraints
+            WHERE table_schema = CURRENT_SCHEMA() AND table_name = t AND constraint_type = 'PRIMARY KEY'
+        ) THEN
+            EXECUTE format('ALTER TABLE %I ADD PRIMARY KEY (id)', t);
+        END IF;
+    END LOOP;
+END $$;
+
+CREATE TABLE IF NOT EXISTS users (
+    id text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    email varchar(255) NOT NULL,
+    username varchar(255) NOT NULL,
+    role varchar(255) NOT NULL,
+    password varchar(255) NOT NULL,
+    password_reset_required boolean NOT NULL DEFAULT false,
+    PRIMARY KEY (id),
+    CONSTRAINT uni_users_email UNIQUE (email)
+);
+
+-- Tables created before the baseline keep their columns, the ones added
+-- since are created here before any index needs them.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS password_reset_required boolean NOT NULL DEFAULT false;
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_users_created_at_id ON users (created_at, id);
+
+CREATE TABLE IF NOT EXISTS tags (
+    id text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    name varchar(100) NOT NULL,
+    slug varchar(100) NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT uni_tags_name UNIQUE (name),
+    CONSTRAINT uni_tags_slug UNIQUE (slug)
+);
+CREATE INDEX IF NOT EXISTS idx_tags_deleted_at ON tags (deleted_at);
+
+CREATE TABLE IF NOT EXISTS categories (
+    id text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    name varchar(100) NOT NULL,
+    slug varchar(100) NOT NULL,
+    description text,
+    PRIMARY KEY (id),
+    CONSTRAINT uni_categories_name UNIQUE (name),
+    CONSTRAINT uni_categories_slug UNIQUE (slug)
+);
+CREATE INDEX IF NOT EXISTS idx_categories_deleted_at ON categories (deleted_at);
+
+CREATE TABLE IF NOT EXISTS blogs (
+    id text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    title varchar(255) NOT NULL,
+    body text NOT NULL,
+    format varchar(20) NOT NULL DEFAULT 'plain',
+    image varchar(255) NOT NULL,
+    user_id text NOT NULL,
+    language regconfig NOT NULL DEFAULT 'simple',
+    hidden_at timestamptz,
+    search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector(language, coalesce(title, '')), 'A') ||
+        setweight(to_tsvector(language, coalesce(body, '')), 'B')
+    ) STORED,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_users_blogs FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+ALTER TABLE blogs ADD COLUMN IF NOT EXISTS format varchar(20) NOT NULL DEFAULT 'plain';
+ALTER TABLE blogs ADD COLUMN IF NOT EXISTS language regconfig NOT NULL DEFAULT 'simple';
+ALTER TABLE blogs ADD COLUMN IF NOT EXISTS hidden_at timestamptz;
+ALTER TABLE blogs ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector(language, coalesce(title, '')), 'A') ||
+    setweight(to_tsvector(language, coalesce(body, '')), 'B')
+) STORED;
+CREATE INDEX IF NOT EXISTS idx_blogs_search_vector ON blogs USING gin (search_vector);
+CREATE INDEX IF NOT EXISTS idx_blogs_hidden_at ON blogs (hidden_at);
+CREATE INDEX IF NOT EXISTS idx_blogs_deleted_at ON blogs (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_blogs_created_at_id ON blogs (created_at, id);
+CREATE INDEX IF NOT EXISTS idx_blogs_updated_at ON blogs (updated_at);
+CREATE INDEX IF NOT EXISTS idx_blogs_title_trgm ON blogs USING gin (title gin_trgm_ops);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_blogs_user_title ON blogs (user_id, LOWER(title)) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS blog_tags (
+    blog_id text,
+    tag_id text,
+    PRIMARY KEY (blog_id, tag_id),
+    CONSTRAINT fk_blog_tags_blog FOREIGN KEY (blog_id) REFERENCES blogs (id),
+    CONSTRAINT fk_blog_tags_tag FOREIGN KEY (tag_id) REFERENCES tags (id)
+);
+
+CREATE TABLE IF NOT EXISTS blog_categories (
+    blog_id text,
+    category_id text,
+    PRIMARY KEY (blog_id, category_id),
+    CONSTRAINT fk_blog_categories_blog FOREIGN KEY (blog_id) REFERENCES blogs (id),
+    CONSTRAINT fk_blog_categories_category FOREIGN KEY (category_id) REFERENCES categories (id)
+);
+
+CREATE TABLE IF NOT EXISTS blog_revisions (
+    id varchar(255),
+    blog_id text NOT NULL,
+    number bigint NOT NULL,
+    title varchar(255) NOT NULL,
+    body text NOT NULL,
+    image varchar(255) NOT NULL,
+    editor_id text NOT NULL,
+    created_at timestamptz,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_blog_revisions_blog FOREIGN KEY (blog_id) REFERENCES blogs (id),
+    CONSTRAINT fk_blog_revisions_editor FOREIGN KEY (editor_id) REFERENCES users (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_blog_revision_number ON blog_revisions (blog_id, number);
+
+CREATE TABLE IF NOT EXISTS comments (
+    id text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    blog_id text NOT NULL,
+    user_id text NOT NULL,
+    parent_id text,
+    body text NOT NULL,
+    hidden_at timestamptz,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_comments_user FOREIGN KEY (user_id) REFERENCES users (id),
+    CONSTRAINT fk_comments_replies FOREIGN KEY (parent_id) REFERENCES comments (id),
+    CONSTRAINT fk_comments_blog FOREIGN KEY (blog_id) REFERENCES blogs (id)
+);
+
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS hidden_at timestamptz;
+CREATE INDEX IF NOT EXISTS idx_comments_hidden_at ON comments (hidden_at);
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments (parent_id);
+CREATE INDEX IF NOT EXISTS idx_comments_blog_id ON comments (blog_id);
+CREATE INDEX IF NOT EXISTS idx_comments_deleted_at ON comments (deleted_at);
+
+CREATE TABLE IF NOT EXISTS reactions (
+    id text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    user_id text NOT NULL,
+    target_type varchar(20) NOT NULL,
+    target_id varchar(255) NOT NULL,
+    type varchar(20) NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_reactions_user FOREIGN KEY (user_id) REFERENCES users (id)
+);
+CREATE INDEX IF NOT EXISTS idx_reaction_target ON reactions (target_type, target_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reaction_user_target ON reactions (user_id, target_type, target_id);
+CREATE INDEX IF NOT EXISTS idx_reactions_deleted_at ON reactions (deleted_at);
+
+CREATE TABLE IF NOT EXISTS follows (
+    follower_id text,
+    following_id text,
+    created_at timestamptz,
+    PRIMARY KEY (follower_id, following_id),
+    CONSTRAINT fk_follows_follower FOREIGN KEY (follower_id) REFERENCES users (id),
+    CONSTRAINT fk_follows_following FOREIGN KEY (following_id) REFERENCES users (id)
+);
+CREATE INDEX IF NOT EXISTS idx_follow_following ON follows (following_id);
+
+CREATE TABLE IF NOT EXISTS bookmark_folders (
+    id text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    user_id text NOT NULL,
+    name varchar(100) NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_bookmark_folders_user FOREIGN KEY (user_id) REFERENCES users (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bookmark_folder_user_name ON bookmark_folders (user_id, name);
+CREATE INDEX IF NOT EXISTS idx_bookmark_folders_deleted_at ON bookmark_folders (deleted_at);
+
+CREATE TABLE IF NOT EXISTS bookmarks (
+    user_id text,
+    blog_id text,
+    folder_id text,
+    created_at timestamptz,
+    PRIMARY KEY (user_id, blog_id),
+    CONSTRAINT fk_bookmarks_user FOREIGN KEY (user_id) REFERENCES users (id),
+    CONSTRAINT fk_bookmarks_blog FOREIGN KEY (blog_id) REFERENCES blogs (id),
+    CONSTRAINT fk_bookmarks_folder FOREIGN KEY (folder_id) REFERENCES bookmark_folders (id) ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS idx_bookmark_folder ON bookmarks (folder_id);
+CREATE INDEX IF NOT EXISTS idx_bookmark_blog ON bookmarks (blog_id);
+
+CREATE TABLE IF NOT EXISTS blog_view_stats (
+    blog_id text,
+    day date,
+    views bigint NOT NULL DEFAULT 0,
+    unique_visitors bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY (blog_id, day),
+    CONSTRAINT fk_blog_view_stats_blog FOREIGN KEY (blog_id) REFERENCES blogs (id)
+);
+
+CREATE TABLE IF NOT EXISTS blog_view_referrers (
+    blog_id text,
+    day date,
+    referrer varchar(255),
+    views bigint NOT NULL DEFAULT 0,
+    PRIMARY KEY (blog_id, day, referrer),
+    CONSTRAINT fk_blog_view_referrers_blog FOREIGN KEY (blog_id) REFERENCES blogs (id)
+);
+
+CREATE TABLE IF NOT EXISTS blog_view_visitors (
+    blog_id text,
+    day date,
+    visitor varchar(64),
+    PRIMARY KEY (blog_id, day, visitor),
+    CONSTRAINT fk_blog_view_visitors_blog FOREIGN KEY (blog_id) REFERENCES blogs (id)
+);
+
+CREATE TABLE IF NOT EXISTS reports (
+    id text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    reporter_id text NOT NULL,
+    target_type varchar(20) NOT NULL,
+    target_id varchar(255) NOT NULL,
+    reason varchar(20) NOT NULL,
+    details text,
+    status varchar(20) NOT NULL DEFAULT 'pending',
+    resolved_by varchar(255),
+    resolved_at timestamptz,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_reports_reporter FOREIGN KEY (reporter_id) REFERENCES users (id)
+);
+CREATE INDEX IF NOT EXISTS idx_reports_status ON reports (status);
+CREATE INDEX IF NOT EXISTS idx_report_target ON reports (target_type, target_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_report_reporter_target ON reports (reporter_id, target_type, target_id);
+CREATE INDEX IF NOT EXISTS idx_reports_deleted_at ON reports (deleted_at);
+
+CREATE TABLE IF NOT EXISTS series (
+    id text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    user_id text NOT NULL,
+    title varchar(255) NOT NULL,
+    description text,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_series_user FOREIGN KEY (user_id) REFERENCES users (id)
+);
+CREATE INDEX IF NOT EXISTS idx_series_deleted_at ON series (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_series_user_id ON series (user_id);
+
+CREATE TABLE IF NOT EXISTS series_blogs (
+    blog_id text,
+    series_id text NOT NULL,
+    position bigint NOT NULL,
+    PRIMARY KEY (blog_id),
+    CONSTRAINT fk_series_blogs_blog FOREIGN KEY (blog_id) REFERENCES blogs (id),
+    CONSTRAINT fk_series_blogs_series FOREIGN KEY (series_id) REFERENCES series (id)
+);
+CREATE INDEX IF NOT EXISTS idx_series_blog_position ON series_blogs (series_id, position);
+
+CREATE TABLE IF NOT EXISTS blog_contributors (
+    blog_id text,
+    user_id text,
+    role varchar(20) NOT NULL,
+    created_at timestamptz,
+    PRIMARY KEY (blog_id, user_id),
+    CONSTRAINT fk_blog_contributors_user FOREIGN KEY (user_id) REFERENCES users (id),
+    CONSTRAINT fk_blogs_contributors FOREIGN KEY (blog_id) REFERENCES blogs (id)
+);
+CREATE INDEX IF NOT EXISTS idx_blog_contributor_user ON blog_contributors (user_id);
+
+CREATE TABLE IF NOT EXISTS blog_translations (
+    blog_id text,
+    locale varchar(10),
+    title varchar(255) NOT NULL,
+    body text NOT NULL,
+    slug varchar(255) NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (blog_id, locale),
+    CONSTRAINT fk_blog_translations_blog FOREIGN KEY (blog_id) REFERENCES blogs (id)
+);
+CREATE INDEX IF NOT EXISTS idx_blog_translations_slug ON blog_translations (slug);
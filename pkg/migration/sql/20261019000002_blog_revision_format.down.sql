@@ -0,0 +1 @@
+ALTER TABLE blog_revisions DROP COLUMN IF EXISTS format;
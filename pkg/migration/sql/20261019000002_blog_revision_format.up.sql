@@ -0,0 +1,11 @@
+-- Revisions taken before the format was recorded get the current format of
+-- their blog, the closest guess available.
+ALTER TABLE blog_revisions ADD COLUMN IF NOT EXISTS format varchar(20);
+
+UPDATE blog_revisions r
+SET format = b.format
+FROM blogs b
+WHERE b.id = r.blog_id AND r.format IS NULL;
+
+ALTER TABLE blog_revisions ALTER COLUMN format SET DEFAULT 'plain';
+ALTER TABLE blog_revisions ALTER COLUMN format SET NOT NULL;
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"learn/fiber/pkg/enum"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Base replaces gorm.Model for entities with their own id, the uint ID of
+// gorm.Model shared the id column with the string Id and was never used.
+type Base struct {
+	Id        string         `gorm:"primaryKey" json:"id"`
+	CreatedAt time.Time      `json:"createdAt"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// NewId returns the prefix followed by a UUIDv7, which grows with time so new
+// rows are appended to the primary key index instead of scattered over it.
+func NewId(prefix enum.EIdPrefix) string {
+	return string(prefix) + "-" + uuid.Must(uuid.NewV7()).String()
+}
+
+// IsId reports whether id is the prefix followed by a UUID. Any UUID version
+// passes, ids created before UUIDv7 are random ones.
+func IsId(id string, prefix enum.EIdPrefix) bool {
+	value, ok := strings.CutPrefix(id, string(prefix)+"-")
+
+	if !ok || len(value) != 36 {
+		return false
+	}
+
+	_, err := uuid.Parse(value)
+
+	return err == nil
+}
@@ -0,0 +1,17 @@
+package entity
+
+import (
+	"learn/fiber/pkg/enum"
+	"time"
+)
+
+// BlogContributor gives a user other than the owner a role on a blog, both
+// co-authors and editors can edit it. Rows are hard deleted.
+type BlogContributor struct {
+	BlogId    string                `gorm:"primaryKey; type:varchar(255)" json:"blogId"`
+	UserId    string                `gorm:"primaryKey; type:varchar(255); index:idx_blog_contributor_user" json:"userId"`
+	Role      enum.EContributorRole `gorm:"type:varchar(20); not null" json:"role"`
+	CreatedAt time.Time             `json:"createdAt"`
+	Blog      Blog                  `gorm:"foreignKey:BlogId" json:"-"`
+	User      User                  `gorm:"foreignKey:UserId" json:"-"`
+}
@@ -1,21 +1,34 @@
 package entity
 
 import (
-	"github.com/google/uuid"
+	"learn/fiber/pkg/enum"
+	"time"
+
 	"gorm.io/gorm"
 )
 
 type Blog struct {
-	gorm.Model
-	Id     string `gorm:"primary_key" json:"id"`
-	Title  string `gorm:"type:varchar(255); not null;" json:"title"`
-	Body   string `gorm:"type:text; not null;" json:"body"`
-	Image  string `gorm:"type:varchar(255); not null;" json:"image"`
-	UserId string `gorm:"type:varchar(255); not null;" json:"userId"`
-	User   User   `gorm:"foreignKey:UserId" json:"-"`
+	Base
+	Title      string           `gorm:"type:varchar(255); not null;" json:"title"`
+	Body       string           `gorm:"type:text; not null;" json:"body"`
+	Format     enum.EBlogFormat `gorm:"type:varchar(20); not null; default:'plain'" json:"format"`
+	Image      string           `gorm:"type:varchar(255); not null;" json:"image"`
+	UserId     string           `gorm:"type:varchar(255); not null;" json:"userId"`
+	Language   enum.ELanguage   `gorm:"type:regconfig; not null; default:'simple'" json:"language"`
+	HiddenAt   *time.Time       `gorm:"index" json:"hiddenAt"`
+	User       User             `gorm:"foreignKey:UserId" json:"-"`
+	Tags       []Tag            `gorm:"many2many:blog_tags;" json:"tags,omitempty"`
+	Categories []Category       `gorm:"many2many:blog_categories;" json:"categories,omitempty"`
+
+	// Contributors are the co-authors and editors besides the owner.
+	Contributors []BlogContributor `gorm:"foreignKey:BlogId" json:"contributors,omitempty"`
+
+	// SearchVector is maintained by PostgreSQL and only exists for indexing,
+	// it is never read or written by the application.
+	SearchVector string `gorm:"->:false; type:tsvector GENERATED ALWAYS AS (setweight(to_tsvector(language, coalesce(title, '')), 'A') || setweight(to_tsvector(language, coalesce(body, '')), 'B')) STORED; index:idx_blogs_search_vector,type:gin" json:"-"`
 }
 
 func (blog *Blog) BeforeCreate(db *gorm.DB) error {
-	blog.Id = "blog-" + uuid.New().String()
+	blog.Id = NewId(enum.ID_PREFIX_BLOG)
 	return nil
 }
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"learn/fiber/pkg/enum"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// BlogRevision is an immutable snapshot of a blog taken on every edit, it
+// has no UpdatedAt or DeletedAt on purpose.
+type BlogRevision struct {
+	Id        string           `gorm:"primary_key; type:varchar(255)" json:"id"`
+	BlogId    string           `gorm:"type:varchar(255); not null; uniqueIndex:idx_blog_revision_number" json:"blogId"`
+	Number    int              `gorm:"not null; uniqueIndex:idx_blog_revision_number" json:"number"`
+	Title     string           `gorm:"type:varchar(255); not null;" json:"title"`
+	Body      string           `gorm:"type:text; not null;" json:"body"`
+	Format    enum.EBlogFormat `gorm:"type:varchar(20); not null; default:'plain'" json:"format"`
+	Image     string           `gorm:"type:varchar(255); not null;" json:"image"`
+	EditorId  string           `gorm:"type:varchar(255); not null;" json:"editorId"`
+	CreatedAt time.Time        `json:"createdAt"`
+	Blog      Blog             `gorm:"foreignKey:BlogId" json:"-"`
+	Editor    User             `gorm:"foreignKey:EditorId" json:"-"`
+}
+
+func (revision *BlogRevision) BeforeCreate(db *gorm.DB) error {
+	revision.Id = NewId(enum.ID_PREFIX_REVISION)
+	return nil
+}
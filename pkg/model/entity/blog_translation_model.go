@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"learn/fiber/pkg/enum"
+	"time"
+)
+
+// BlogTranslation holds a blog in another locale than it was written in, a
+// blog has at most one translation per locale. Rows are hard deleted.
+type BlogTranslation struct {
+	BlogId    string       `gorm:"primaryKey; type:varchar(255)" json:"blogId"`
+	Locale    enum.ELocale `gorm:"primaryKey; type:varchar(10)" json:"locale"`
+	Title     string       `gorm:"type:varchar(255); not null;" json:"title"`
+	Body      string       `gorm:"type:text; not null;" json:"body"`
+	Slug      string       `gorm:"type:varchar(255); not null; index" json:"slug"`
+	CreatedAt time.Time    `json:"createdAt"`
+	UpdatedAt time.Time    `json:"updatedAt"`
+	Blog      Blog         `gorm:"foreignKey:BlogId" json:"-"`
+}
@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+// BlogViewStat is the daily aggregate of the views of a blog, rows are
+// upserted in batches by the view tracker.
+type BlogViewStat struct {
+	BlogId         string    `gorm:"primaryKey; type:varchar(255)" json:"blogId"`
+	Day            time.Time `gorm:"primaryKey; type:date" json:"day"`
+	Views          int64     `gorm:"not null; default:0" json:"views"`
+	UniqueVisitors int64     `gorm:"not null; default:0" json:"uniqueVisitors"`
+	Blog           Blog      `gorm:"foreignKey:BlogId" json:"-"`
+}
+
+// BlogViewReferrer counts the views of a blog per referring host and day,
+// views without a referrer are stored under "direct".
+type BlogViewReferrer struct {
+	BlogId   string    `gorm:"primaryKey; type:varchar(255)" json:"blogId"`
+	Day      time.Time `gorm:"primaryKey; type:date" json:"day"`
+	Referrer string    `gorm:"primaryKey; type:varchar(255)" json:"referrer"`
+	Views    int64     `gorm:"not null; default:0" json:"views"`
+	Blog     Blog      `gorm:"foreignKey:BlogId" json:"-"`
+}
+
+// BlogViewVisitor remembers which visitors saw a blog on a day so unique
+// visitors can be counted over any range. Visitor is a hash, IP addresses
+// and user agents are never stored.
+type BlogViewVisitor struct {
+	BlogId  string    `gorm:"primaryKey; type:varchar(255)" json:"blogId"`
+	Day     time.Time `gorm:"primaryKey; type:date" json:"day"`
+	Visitor string    `gorm:"primaryKey; type:varchar(64)" json:"visitor"`
+	Blog    Blog      `gorm:"foreignKey:BlogId" json:"-"`
+}
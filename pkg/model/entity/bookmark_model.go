@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"learn/fiber/pkg/enum"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Bookmark is hard deleted when toggled off, a blog is bookmarked at most
+// once per user and lives in at most one folder.
+type Bookmark struct {
+	UserId    string          `gorm:"primaryKey; type:varchar(255)" json:"userId"`
+	BlogId    string          `gorm:"primaryKey; type:varchar(255); index:idx_bookmark_blog" json:"blogId"`
+	FolderId  *string         `gorm:"type:varchar(255); index:idx_bookmark_folder" json:"folderId"`
+	CreatedAt time.Time       `json:"createdAt"`
+	User      User            `gorm:"foreignKey:UserId" json:"-"`
+	Blog      Blog            `gorm:"foreignKey:BlogId" json:"-"`
+	Folder    *BookmarkFolder `gorm:"foreignKey:FolderId; constraint:OnDelete:SET NULL" json:"-"`
+}
+
+// BookmarkFolder is hard deleted, so the unique index keeps folder names
+// unique per user.
+type BookmarkFolder struct {
+	Base
+	UserId string `gorm:"type:varchar(255); not null; uniqueIndex:idx_bookmark_folder_user_name" json:"userId"`
+	Name   string `gorm:"type:varchar(100); not null; uniqueIndex:idx_bookmark_folder_user_name" json:"name"`
+	User   User   `gorm:"foreignKey:UserId" json:"-"`
+}
+
+func (folder *BookmarkFolder) BeforeCreate(db *gorm.DB) error {
+	folder.Id = NewId(enum.ID_PREFIX_FOLDER)
+	return nil
+}
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"learn/fiber/pkg/enum"
+
+	"gorm.io/gorm"
+)
+
+type Category struct {
+	Base
+	Name        string `gorm:"type:varchar(100); not null; unique" json:"name"`
+	Slug        string `gorm:"type:varchar(100); not null; unique" json:"slug"`
+	Description string `gorm:"type:text;" json:"description"`
+	Blogs       []Blog `gorm:"many2many:blog_categories;" json:"-"`
+}
+
+func (category *Category) BeforeCreate(db *gorm.DB) error {
+	category.Id = NewId(enum.ID_PREFIX_CATEGORY)
+	return nil
+}
@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"learn/fiber/pkg/enum"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Comment struct {
+	Base
+	BlogId   string     `gorm:"type:varchar(255); not null; index" json:"blogId"`
+	UserId   string     `gorm:"type:varchar(255); not null;" json:"userId"`
+	ParentId *string    `gorm:"type:varchar(255); index" json:"parentId"`
+	Body     string     `gorm:"type:text; not null;" json:"body"`
+	HiddenAt *time.Time `gorm:"index" json:"hiddenAt"`
+	Blog     Blog       `gorm:"foreignKey:BlogId" json:"-"`
+	User     User       `gorm:"foreignKey:UserId" json:"-"`
+	Parent   *Comment   `gorm:"foreignKey:ParentId" json:"-"`
+	Replies  []Comment  `gorm:"foreignKey:ParentId" json:"-"`
+}
+
+func (comment *Comment) BeforeCreate(db *gorm.DB) error {
+	comment.Id = NewId(enum.ID_PREFIX_COMMENT)
+	return nil
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// Follow is hard deleted on unfollow, the composite primary key allows a
+// single row per follower and followed user.
+type Follow struct {
+	FollowerId  string    `gorm:"primaryKey; type:varchar(255)" json:"followerId"`
+	FollowingId string    `gorm:"primaryKey; type:varchar(255); index:idx_follow_following" json:"followingId"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Follower    User      `gorm:"foreignKey:FollowerId" json:"-"`
+	Following   User      `gorm:"foreignKey:FollowingId" json:"-"`
+}
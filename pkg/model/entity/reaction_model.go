@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"learn/fiber/pkg/enum"
+
+	"gorm.io/gorm"
+)
+
+// Reaction is hard deleted when toggled off, so the unique index always
+// allows a single reaction per user and target.
+type Reaction struct {
+	Base
+	UserId     string               `gorm:"type:varchar(255); not null; uniqueIndex:idx_reaction_user_target" json:"userId"`
+	TargetType enum.EReactionTarget `gorm:"type:varchar(20); not null; uniqueIndex:idx_reaction_user_target; index:idx_reaction_target" json:"targetType"`
+	TargetId   string               `gorm:"type:varchar(255); not null; uniqueIndex:idx_reaction_user_target; index:idx_reaction_target" json:"targetId"`
+	Type       enum.EReaction       `gorm:"type:varchar(20); not null;" json:"type"`
+	User       User                 `gorm:"foreignKey:UserId" json:"-"`
+}
+
+func (reaction *Reaction) BeforeCreate(db *gorm.DB) error {
+	reaction.Id = NewId(enum.ID_PREFIX_REACTION)
+	return nil
+}
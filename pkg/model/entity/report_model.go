@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"learn/fiber/pkg/enum"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Report is a complaint about a blog or comment, a user can report the same
+// content only once.
+type Report struct {
+	Base
+	ReporterId string             `gorm:"type:varchar(255); not null; uniqueIndex:idx_report_reporter_target" json:"reporterId"`
+	TargetType enum.EReportTarget `gorm:"type:varchar(20); not null; uniqueIndex:idx_report_reporter_target; index:idx_report_target" json:"targetType"`
+	TargetId   string             `gorm:"type:varchar(255); not null; uniqueIndex:idx_report_reporter_target; index:idx_report_target" json:"targetId"`
+	Reason     enum.EReportReason `gorm:"type:varchar(20); not null;" json:"reason"`
+	Details    string             `gorm:"type:text" json:"details"`
+	Status     enum.EReportStatus `gorm:"type:varchar(20); not null; default:'pending'; index" json:"status"`
+	ResolvedBy *string            `gorm:"type:varchar(255)" json:"resolvedBy"`
+	ResolvedAt *time.Time         `json:"resolvedAt"`
+	Reporter   User               `gorm:"foreignKey:ReporterId" json:"-"`
+}
+
+func (report *Report) BeforeCreate(db *gorm.DB) error {
+	report.Id = NewId(enum.ID_PREFIX_REPORT)
+	return nil
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"learn/fiber/pkg/enum"
+
+	"gorm.io/gorm"
+)
+
+// Series groups blogs of one author into an ordered multi-part post.
+type Series struct {
+	Base
+	UserId      string `gorm:"type:varchar(255); not null; index" json:"userId"`
+	Title       string `gorm:"type:varchar(255); not null;" json:"title"`
+	Description string `gorm:"type:text;" json:"description"`
+	User        User   `gorm:"foreignKey:UserId" json:"-"`
+}
+
+func (series *Series) BeforeCreate(db *gorm.DB) error {
+	series.Id = NewId(enum.ID_PREFIX_SERIES)
+	return nil
+}
+
+// SeriesBlog places a blog in a series, keyed by blog so a blog belongs to at
+// most one series. Rows are hard deleted with their series.
+type SeriesBlog struct {
+	BlogId   string `gorm:"primaryKey; type:varchar(255)" json:"blogId"`
+	SeriesId string `gorm:"type:varchar(255); not null; index:idx_series_blog_position" json:"seriesId"`
+	Position int    `gorm:"not null; index:idx_series_blog_position" json:"position"`
+	Series   Series `gorm:"foreignKey:SeriesId" json:"-"`
+	Blog     Blog   `gorm:"foreignKey:BlogId" json:"-"`
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"learn/fiber/pkg/enum"
+
+	"gorm.io/gorm"
+)
+
+type Tag struct {
+	Base
+	Name  string `gorm:"type:varchar(100); not null; unique" json:"name"`
+	Slug  string `gorm:"type:varchar(100); not null; unique" json:"slug"`
+	Blogs []Blog `gorm:"many2many:blog_tags;" json:"-"`
+}
+
+func (tag *Tag) BeforeCreate(db *gorm.DB) error {
+	tag.Id = NewId(enum.ID_PREFIX_TAG)
+	return nil
+}
@@ -4,31 +4,36 @@ import (
 	"learn/fiber/pkg/enum"
 	"time"
 
-	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type User struct {
-	gorm.Model
-	Id       string     `gorm:"primary_key" json:"id"`
+	Base
 	Email    string     `gorm:"type:varchar(255); not null; unique" json:"email"`
 	Username string     `gorm:"type:varchar(255); not null;" json:"username"`
 	Role     enum.ERole `gorm:"type:varchar(255); not null;" json:"role"`
 	Password string     `gorm:"type:varchar(255); not null;" json:"password"`
 	Blogs    []Blog     `gorm:"foreignKey:UserId" json:"blogs,omitempty"`
+
+	// PasswordResetRequired marks accounts created by an import, their
+	// password is random and has to be reset before they can log in.
+	PasswordResetRequired bool `gorm:"not null; default:false" json:"passwordResetRequired"`
 }
 
 func (user *User) BeforeCreate(db *gorm.DB) error {
-	user.Id = "user-" + uuid.New().String()
+	user.Id = NewId(enum.ID_PREFIX_USER)
 	return nil
 }
 
+// UserRegisterRequest is the public sign up, it can only create users.
+// Admins and moderators are promoted by an admin or created with the
+// "user create-admin" command.
 type UserRegisterRequest struct {
 	Email           string     `validate:"required,email" json:"email"`
 	Username        string     `validate:"required" json:"username"`
 	Password        string     `validate:"required" json:"password"`
 	ConfirmPassword string     `validate:"required" json:"confirmPassword"`
-	Role            enum.ERole `validate:"required,oneof=admin user" json:"role"`
+	Role            enum.ERole `validate:"omitempty,oneof=user" json:"role"`
 }
 
 type UserLoginRequest struct {
@@ -39,15 +44,24 @@ type UserLoginRequest struct {
 type UserUpdateRequest struct {
 	Email    string     `validate:"omitempty,email" json:"email"`
 	Username string     `validate:"omitempty" json:"username"`
-	Role     enum.ERole `validate:"omitempty,oneof=admin user" json:"role"`
+	Role     enum.ERole `validate:"omitempty,oneof=admin moderator user" json:"role"`
+}
+
+// UserPasswordRequest is how an admin sets the password of a user, such as
+// the authors created by an import.
+type UserPasswordRequest struct {
+	Password        string `validate:"required" json:"password"`
+	ConfirmPassword string `validate:"required" json:"confirmPassword"`
 }
 
 type UserResponse struct {
-	Id        string     `json:"id"`
-	Email     string     `json:"email"`
-	Username  string     `json:"username"`
-	Role      enum.ERole `json:"role"`
-	CreatedAt time.Time  `json:"createdAt"`
-	UpdatedAt time.Time  `json:"updatedAt"`
-	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+	Id                    string     `json:"id"`
+	Email                 string     `json:"email"`
+	Username              string     `json:"username"`
+	Role                  enum.ERole `json:"role"`
+	FollowerCount         int64      `json:"followerCount"`
+	FollowingCount        int64      `json:"followingCount"`
+	PasswordResetRequired bool       `json:"passwordResetRequired"`
+	CreatedAt             time.Time  `json:"createdAt"`
+	UpdatedAt             time.Time  `json:"updatedAt"`
 }
@@ -1,7 +1,83 @@
 package req
 
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model"
+	"strings"
+)
+
 type CreateBlogDto struct {
-	Title string `json:"title"`
-	Body  string `json:"body"`
-	Image string `json:"image"`
+	Title       string           `json:"title" validate:"required,notblank,min=3,max=255"`
+	Body        string           `json:"body" validate:"required,notblank,max=100000"`
+	Format      enum.EBlogFormat `json:"format" validate:"omitempty,oneof=markdown html plain" enums:"markdown,html,plain" default:"markdown"`
+	Image       string           `json:"image" validate:"omitempty,max=255"`
+	Language    enum.ELanguage   `json:"language" validate:"omitempty,oneof=english indonesian simple" enums:"english,indonesian,simple"`
+	Tags        []string         `json:"tags" validate:"omitempty,max=10,dive,notblank,max=100"`
+	CategoryIds []string         `json:"categoryIds" validate:"omitempty,max=10,dive,notblank"`
+}
+
+// Normalize trims the title before it is validated, so a title padded with
+// spaces has to meet the length rules without them.
+func (dto *CreateBlogDto) Normalize() {
+	dto.Title = strings.TrimSpace(dto.Title)
+}
+
+type BlogPaginationRequest struct {
+	model.PaginationRequest
+	Tag      string `json:"tag" query:"tag" validate:"omitempty"`
+	Category string `json:"category" query:"category" validate:"omitempty"`
+	Lang     string `json:"lang" query:"lang" validate:"omitempty" enums:"en,id"`
+
+	// Locales are the locales the reader prefers, negotiated from lang and
+	// the Accept-Language header.
+	Locales []enum.ELocale `json:"-" query:"-" swaggerignore:"true"`
+}
+
+type BlogCursorRequest struct {
+	model.CursorPaginationRequest
+	Tag      string `json:"tag" query:"tag" validate:"omitempty"`
+	Category string `json:"category" query:"category" validate:"omitempty"`
+}
+
+type SearchBlogRequest struct {
+	Query    string         `json:"q" query:"q" validate:"required,max=200"`
+	Language enum.ELanguage `json:"lang" query:"lang" validate:"omitempty,oneof=english indonesian simple" enums:"english,indonesian,simple"`
+	Page     int            `json:"page" query:"page" validate:"omitempty,min=1"`
+	Limit    int            `json:"limit" query:"limit" validate:"omitempty,min=1,max=100"`
+}
+
+type RelatedBlogRequest struct {
+	Limit         int    `json:"limit" query:"limit" validate:"omitempty,min=1,max=20"`
+	ExcludeAuthor bool   `json:"excludeAuthor" query:"excludeAuthor"`
+	Lang          string `json:"lang" query:"lang" validate:"omitempty" enums:"en,id"`
+
+	// Locales are the locales the reader prefers, negotiated from lang and
+	// the Accept-Language header.
+	Locales []enum.ELocale `json:"-" query:"-" swaggerignore:"true"`
+}
+
+type UpdateBlogDto struct {
+	Title  string           `json:"title" validate:"required,notblank,min=3,max=255"`
+	Body   string           `json:"body" validate:"required,notblank,max=100000"`
+	Format enum.EBlogFormat `json:"format" validate:"omitempty,oneof=markdown html plain" enums:"markdown,html,plain"`
+	Image  string           `json:"image" validate:"omitempty,max=255"`
+}
+
+func (dto *UpdateBlogDto) Normalize() {
+	dto.Title = strings.TrimSpace(dto.Title)
+}
+
+type BlogRevisionDiffRequest struct {
+	From int `json:"from" query:"from" validate:"required,min=1"`
+	To   int `json:"to" query:"to" validate:"required,min=1"`
+}
+
+type BlogViewStatsRequest struct {
+	From      string `json:"from" query:"from" validate:"omitempty,datetime=2006-01-02"`
+	To        string `json:"to" query:"to" validate:"omitempty,datetime=2006-01-02"`
+	Referrers int    `json:"referrers" query:"referrers" validate:"omitempty,min=1,max=50"`
+}
+
+type BlogExportRequest struct {
+	UserId string `json:"userId" query:"userId" validate:"omitempty"`
 }
@@ -0,0 +1,9 @@
+package req
+
+// BlogTranslationDto is written in the format of the blog, slug defaults to
+// the slug of the title.
+type BlogTranslationDto struct {
+	Title string `json:"title" validate:"required,notblank,min=3,max=255"`
+	Body  string `json:"body" validate:"required,notblank,max=100000"`
+	Slug  string `json:"slug" validate:"omitempty,max=255"`
+}
@@ -0,0 +1,15 @@
+package req
+
+type BookmarkDto struct {
+	FolderId *string `json:"folderId" validate:"omitempty"`
+}
+
+type BookmarkFolderDto struct {
+	Name string `json:"name" validate:"required,notblank,max=100"`
+}
+
+type BookmarkPaginationRequest struct {
+	Page     int    `json:"page" query:"page" validate:"omitempty,min=1"`
+	Limit    int    `json:"limit" query:"limit" validate:"omitempty,min=1,max=100"`
+	FolderId string `json:"folderId" query:"folderId" validate:"omitempty"`
+}
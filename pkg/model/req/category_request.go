@@ -0,0 +1,6 @@
+package req
+
+type CategoryDto struct {
+	Name        string `json:"name" validate:"required,max=100"`
+	Description string `json:"description" validate:"omitempty"`
+}
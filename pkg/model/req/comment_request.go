@@ -0,0 +1,16 @@
+package req
+
+type CreateCommentDto struct {
+	Body     string  `json:"body" validate:"required,max=5000"`
+	ParentId *string `json:"parentId" validate:"omitempty"`
+}
+
+type UpdateCommentDto struct {
+	Body string `json:"body" validate:"required,max=5000"`
+}
+
+type CommentPaginationRequest struct {
+	Page  int    `json:"page" query:"page" validate:"omitempty,min=1"`
+	Limit int    `json:"limit" query:"limit" validate:"omitempty,min=1,max=100"`
+	Mode  string `json:"mode" query:"mode" validate:"omitempty,oneof=nested flat" enums:"nested,flat"`
+}
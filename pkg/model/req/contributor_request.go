@@ -0,0 +1,13 @@
+package req
+
+import "learn/fiber/pkg/enum"
+
+type ContributorDto struct {
+	Role enum.EContributorRole `json:"role" validate:"required,oneof=co-author editor" enums:"co-author,editor"`
+}
+
+type ContributionPaginationRequest struct {
+	Page  int                   `json:"page" query:"page" validate:"omitempty,min=1"`
+	Limit int                   `json:"limit" query:"limit" validate:"omitempty,min=1,max=100"`
+	Role  enum.EContributorRole `json:"role" query:"role" validate:"omitempty,oneof=author co-author editor" enums:"author,co-author,editor"`
+}
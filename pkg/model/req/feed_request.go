@@ -0,0 +1,7 @@
+package req
+
+type FeedRequest struct {
+	Limit  int    `json:"limit" query:"limit" validate:"omitempty,min=1,max=100"`
+	Author string `json:"author" query:"author" validate:"omitempty"`
+	Tag    string `json:"tag" query:"tag" validate:"omitempty"`
+}
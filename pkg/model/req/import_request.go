@@ -0,0 +1,8 @@
+package req
+
+type WordPressImportRequest struct {
+	// SkipMedia keeps links to the WordPress uploads in the posts instead of
+	// copying the files, for sites that are no longer reachable. Featured
+	// images are dropped since a blog image has to be a file of this server.
+	SkipMedia bool `json:"skipMedia" form:"skipMedia"`
+}
@@ -0,0 +1,7 @@
+package req
+
+import "learn/fiber/pkg/enum"
+
+type ReactionDto struct {
+	Type enum.EReaction `json:"type" validate:"required,oneof=like love laugh wow sad" enums:"like,love,laugh,wow,sad"`
+}
@@ -0,0 +1,14 @@
+package req
+
+import "learn/fiber/pkg/enum"
+
+type ReportDto struct {
+	Reason  enum.EReportReason `json:"reason" validate:"required,oneof=spam harassment hate nsfw misinformation other" enums:"spam,harassment,hate,nsfw,misinformation,other"`
+	Details string             `json:"details" validate:"omitempty,max=1000"`
+}
+
+type ModerationQueueRequest struct {
+	Page       int                `json:"page" query:"page" validate:"omitempty,min=1"`
+	Limit      int                `json:"limit" query:"limit" validate:"omitempty,min=1,max=100"`
+	TargetType enum.EReportTarget `json:"targetType" query:"targetType" validate:"omitempty,oneof=blog comment" enums:"blog,comment"`
+}
@@ -0,0 +1,30 @@
+package req
+
+import "learn/fiber/pkg/enum"
+
+// SeedRequest is a fixture of users and blogs, read from YAML or JSON by the
+// seed command.
+type SeedRequest struct {
+	Users []SeedUserDto `json:"users" yaml:"users" validate:"dive"`
+	Blogs []SeedBlogDto `json:"blogs" yaml:"blogs" validate:"dive"`
+}
+
+type SeedUserDto struct {
+	Email    string     `json:"email" yaml:"email" validate:"required,email"`
+	Username string     `json:"username" yaml:"username" validate:"required,notblank,max=255"`
+	Password string     `json:"password" yaml:"password" validate:"required"`
+	Role     enum.ERole `json:"role" yaml:"role" validate:"omitempty,oneof=admin moderator user"`
+}
+
+// SeedBlogDto names its author by email and its categories by name, the ids
+// are not known before seeding.
+type SeedBlogDto struct {
+	Author     string           `json:"author" yaml:"author" validate:"required,email"`
+	Title      string           `json:"title" yaml:"title" validate:"required,notblank,min=3,max=255"`
+	Body       string           `json:"body" yaml:"body" validate:"required,notblank,max=100000"`
+	Format     enum.EBlogFormat `json:"format" yaml:"format" validate:"omitempty,oneof=markdown html plain"`
+	Image      string           `json:"image" yaml:"image" validate:"omitempty,max=255"`
+	Language   enum.ELanguage   `json:"language" yaml:"language" validate:"omitempty,oneof=english indonesian simple"`
+	Tags       []string         `json:"tags" yaml:"tags" validate:"omitempty,max=10,dive,notblank,max=100"`
+	Categories []string         `json:"categories" yaml:"categories" validate:"omitempty,max=10,dive,notblank,max=100"`
+}
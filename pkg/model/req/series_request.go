@@ -0,0 +1,9 @@
+package req
+
+// SeriesDto lists the blogs of the series in reading order, they must all be
+// blogs of the author and may not be part of another series.
+type SeriesDto struct {
+	Title       string   `json:"title" validate:"required,notblank,max=255"`
+	Description string   `json:"description" validate:"omitempty,max=2000"`
+	BlogIds     []string `json:"blogIds" validate:"omitempty,max=100,dive,required"`
+}
@@ -0,0 +1,5 @@
+package req
+
+type TagDto struct {
+	Name string `json:"name" validate:"required,max=100"`
+}
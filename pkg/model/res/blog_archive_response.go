@@ -0,0 +1,22 @@
+package res
+
+import "learn/fiber/pkg/enum"
+
+// BlogImportResponse reports what happened to every post of an imported
+// archive, importing the same archive again leaves every post unchanged.
+type BlogImportResponse struct {
+	Created   int              `json:"created"`
+	Updated   int              `json:"updated"`
+	Unchanged int              `json:"unchanged"`
+	Failed    int              `json:"failed"`
+	Items     []BlogImportItem `json:"items"`
+}
+
+type BlogImportItem struct {
+	File     string             `json:"file"`
+	Status   enum.EImportStatus `json:"status"`
+	BlogId   string             `json:"blogId,omitempty"`
+	Title    string             `json:"title,omitempty"`
+	Error    string             `json:"error,omitempty"`
+	Warnings []string           `json:"warnings,omitempty"`
+}
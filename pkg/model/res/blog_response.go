@@ -6,11 +6,50 @@ type FindOwnBlogResponse struct {
 	Body      string `json:"body"`
 	Image     string `json:"image"`
 	UserId    string `json:"userId"`
+	Language  string `json:"language"`
+	Format    string `json:"format"`
+	Hidden    bool   `json:"hidden"`
 	CreatedAt string `json:"createdAt"`
 	UpdatedAt string `json:"updatedAt"`
 }
 
 type FindBlogResponse struct {
 	FindOwnBlogResponse
-	Owner string `json:"owner"`
+	BodyHtml        string                `json:"bodyHtml" gorm:"-"`
+	Toc             []TocEntry            `json:"toc" gorm:"-"`
+	ReadingTime     int                   `json:"readingTime" gorm:"-"`
+	Owner           string                `json:"owner"`
+	Authors         []ContributorResponse `json:"authors" gorm:"-"`
+	CommentCount    int                   `json:"commentCount"`
+	Bookmarked      bool                  `json:"bookmarked" gorm:"-"`
+	Tags            []TagResponse         `json:"tags" gorm:"-"`
+	Categories      []CategoryResponse    `json:"categories" gorm:"-"`
+	Series          *BlogSeriesResponse   `json:"series,omitempty" gorm:"-"`
+	Slug            string                `json:"slug,omitempty" gorm:"-"`
+	Locale          string                `json:"locale,omitempty" gorm:"-"`
+	Locales         []string              `json:"locales,omitempty" gorm:"-"`
+	ReactionSummary `gorm:"-"`
+}
+
+type SearchBlogResponse struct {
+	FindBlogResponse
+	Rank           float64 `json:"rank"`
+	TitleHighlight string  `json:"titleHighlight"`
+	Snippet        string  `json:"snippet"`
+}
+
+// RelatedBlogResponse is a blog similar to another one, Score weighs shared
+// tags and categories with how alike their texts are.
+type RelatedBlogResponse struct {
+	FindBlogResponse
+	SharedTags int     `json:"sharedTags"`
+	Score      float64 `json:"score"`
+}
+
+// TocEntry is a heading of the rendered body, Id is the anchor it can be
+// linked to with.
+type TocEntry struct {
+	Level int    `json:"level"`
+	Id    string `json:"id"`
+	Title string `json:"title"`
 }
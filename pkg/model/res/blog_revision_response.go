@@ -0,0 +1,27 @@
+package res
+
+import (
+	"learn/fiber/pkg/enum"
+	"time"
+)
+
+type BlogRevisionResponse struct {
+	Number    int              `json:"number"`
+	Title     string           `json:"title"`
+	Format    enum.EBlogFormat `json:"format"`
+	Image     string           `json:"image"`
+	EditorId  string           `json:"editorId"`
+	Editor    string           `json:"editor"`
+	CreatedAt time.Time        `json:"createdAt"`
+}
+
+type BlogRevisionDetailResponse struct {
+	BlogRevisionResponse
+	Body string `json:"body"`
+}
+
+type BlogRevisionDiffResponse struct {
+	From int    `json:"from"`
+	To   int    `json:"to"`
+	Diff string `json:"diff"`
+}
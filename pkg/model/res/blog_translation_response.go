@@ -0,0 +1,12 @@
+package res
+
+import "time"
+
+type BlogTranslationResponse struct {
+	Locale    string    `json:"locale"`
+	Title     string    `json:"title"`
+	Slug      string    `json:"slug"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
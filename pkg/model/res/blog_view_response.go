@@ -0,0 +1,22 @@
+package res
+
+type BlogViewStatsResponse struct {
+	BlogId         string                  `json:"blogId"`
+	From           string                  `json:"from"`
+	To             string                  `json:"to"`
+	Views          int64                   `json:"views"`
+	UniqueVisitors int64                   `json:"uniqueVisitors"`
+	Daily          []BlogViewDailyResponse `json:"daily"`
+	TopReferrers   []BlogReferrerResponse  `json:"topReferrers"`
+}
+
+type BlogViewDailyResponse struct {
+	Day            string `json:"day"`
+	Views          int64  `json:"views"`
+	UniqueVisitors int64  `json:"uniqueVisitors"`
+}
+
+type BlogReferrerResponse struct {
+	Referrer string `json:"referrer"`
+	Views    int64  `json:"views"`
+}
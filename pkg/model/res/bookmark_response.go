@@ -0,0 +1,16 @@
+package res
+
+import "time"
+
+type BookmarkResponse struct {
+	BlogId     string  `json:"blogId"`
+	Bookmarked bool    `json:"bookmarked"`
+	FolderId   *string `json:"folderId"`
+}
+
+type BookmarkFolderResponse struct {
+	Id            string    `json:"id"`
+	Name          string    `json:"name"`
+	BookmarkCount int       `json:"bookmarkCount"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
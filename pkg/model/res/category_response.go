@@ -0,0 +1,13 @@
+package res
+
+type CategoryResponse struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+}
+
+type CategoryWithCountResponse struct {
+	CategoryResponse
+	PostCount int `json:"postCount"`
+}
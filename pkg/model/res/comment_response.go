@@ -0,0 +1,17 @@
+package res
+
+import "time"
+
+type CommentResponse struct {
+	Id              string            `json:"id"`
+	BlogId          string            `json:"blogId"`
+	ParentId        *string           `json:"parentId"`
+	Body            string            `json:"body"`
+	UserId          string            `json:"userId"`
+	Author          string            `json:"author"`
+	Hidden          bool              `json:"hidden"`
+	CreatedAt       time.Time         `json:"createdAt"`
+	UpdatedAt       time.Time         `json:"updatedAt"`
+	Replies         []CommentResponse `json:"replies,omitempty" gorm:"-"`
+	ReactionSummary `gorm:"-"`
+}
@@ -0,0 +1,9 @@
+package res
+
+import "learn/fiber/pkg/enum"
+
+type ContributorResponse struct {
+	UserId   string                `json:"userId"`
+	Username string                `json:"username"`
+	Role     enum.EContributorRole `json:"role"`
+}
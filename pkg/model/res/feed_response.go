@@ -0,0 +1,22 @@
+package res
+
+import "time"
+
+type FeedItem struct {
+	Id        string    `json:"id"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	Image     string    `json:"image"`
+	Author    string    `json:"author"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	Tags      []string  `json:"tags" gorm:"-"`
+}
+
+// Feed is a rendered syndication document ready to be written as is.
+type Feed struct {
+	ContentType  string
+	Body         []byte
+	ETag         string
+	LastModified time.Time
+}
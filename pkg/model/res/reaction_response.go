@@ -0,0 +1,10 @@
+package res
+
+import "learn/fiber/pkg/enum"
+
+type ReactionSummary struct {
+	Reactions     map[enum.EReaction]int `json:"reactions"`
+	ReactionCount int                    `json:"reactionCount"`
+	MyReaction    *enum.EReaction        `json:"myReaction"`
+	LikedByMe     bool                   `json:"likedByMe"`
+}
@@ -0,0 +1,27 @@
+package res
+
+import "time"
+
+type ReportResponse struct {
+	Id         string    `json:"id"`
+	TargetType string    `json:"targetType"`
+	TargetId   string    `json:"targetId"`
+	Reason     string    `json:"reason"`
+	Details    string    `json:"details"`
+	Status     string    `json:"status"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// ModerationQueueItem groups the pending reports of one blog or comment,
+// Excerpt is the blog title or the start of the comment body.
+type ModerationQueueItem struct {
+	TargetType     string     `json:"targetType"`
+	TargetId       string     `json:"targetId"`
+	BlogId         string     `json:"blogId"`
+	AuthorId       string     `json:"authorId"`
+	Excerpt        string     `json:"excerpt"`
+	ReportCount    int64      `json:"reportCount"`
+	Reasons        []string   `json:"reasons" gorm:"-"`
+	HiddenAt       *time.Time `json:"hiddenAt"`
+	LastReportedAt time.Time  `json:"lastReportedAt"`
+}
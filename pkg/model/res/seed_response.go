@@ -0,0 +1,20 @@
+package res
+
+import "learn/fiber/pkg/enum"
+
+// SeedResponse counts what a seed did per kind of content, items only lists
+// the users and blogs that were skipped or failed.
+type SeedResponse struct {
+	Users      ImportCount `json:"users"`
+	Tags       ImportCount `json:"tags"`
+	Categories ImportCount `json:"categories"`
+	Blogs      ImportCount `json:"blogs"`
+	Items      []SeedItem  `json:"items"`
+}
+
+type SeedItem struct {
+	Kind   string             `json:"kind" example:"user"`
+	Source string             `json:"source" example:"admin@example.com"`
+	Status enum.EImportStatus `json:"status"`
+	Reason string             `json:"reason"`
+}
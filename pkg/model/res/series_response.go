@@ -0,0 +1,35 @@
+package res
+
+import "time"
+
+type SeriesResponse struct {
+	Id          string    `json:"id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	UserId      string    `json:"userId"`
+	Owner       string    `json:"owner"`
+	PostCount   int       `json:"postCount"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type SeriesDetailResponse struct {
+	SeriesResponse
+	Posts []FindBlogResponse `json:"posts"`
+}
+
+// BlogSeriesResponse places a blog within its series, previous and next are
+// empty at either end.
+type BlogSeriesResponse struct {
+	Id       string          `json:"id"`
+	Title    string          `json:"title"`
+	Position int             `json:"position"`
+	Total    int             `json:"total"`
+	Previous *SeriesPostLink `json:"previous"`
+	Next     *SeriesPostLink `json:"next"`
+}
+
+type SeriesPostLink struct {
+	Id    string `json:"id"`
+	Title string `json:"title"`
+}
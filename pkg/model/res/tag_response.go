@@ -0,0 +1,12 @@
+package res
+
+type TagResponse struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type TagWithCountResponse struct {
+	TagResponse
+	PostCount int `json:"postCount"`
+}
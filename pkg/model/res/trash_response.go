@@ -0,0 +1,28 @@
+package res
+
+import "time"
+
+// TrashUserResponse is a deleted user, BlogCount counts the blogs deleted
+// together with it. PurgeAt is when both are removed for good, nil when
+// purging is turned off.
+type TrashUserResponse struct {
+	Id        string     `json:"id"`
+	Email     string     `json:"email"`
+	Username  string     `json:"username"`
+	Role      string     `json:"role"`
+	BlogCount int64      `json:"blogCount"`
+	DeletedAt time.Time  `json:"deletedAt"`
+	PurgeAt   *time.Time `json:"purgeAt" gorm:"-"`
+}
+
+// TrashBlogResponse is a deleted blog, a blog of a deleted owner comes back
+// with its owner only.
+type TrashBlogResponse struct {
+	Id           string     `json:"id"`
+	Title        string     `json:"title"`
+	UserId       string     `json:"userId"`
+	Owner        string     `json:"owner"`
+	OwnerDeleted bool       `json:"ownerDeleted"`
+	DeletedAt    time.Time  `json:"deletedAt"`
+	PurgeAt      *time.Time `json:"purgeAt" gorm:"-"`
+}
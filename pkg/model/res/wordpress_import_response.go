@@ -0,0 +1,28 @@
+package res
+
+import "learn/fiber/pkg/enum"
+
+// WordPressImportResponse counts what a WordPress import did per kind of
+// content, items only lists what was skipped or failed.
+type WordPressImportResponse struct {
+	Users      ImportCount           `json:"users"`
+	Categories ImportCount           `json:"categories"`
+	Tags       ImportCount           `json:"tags"`
+	Blogs      ImportCount           `json:"blogs"`
+	Comments   ImportCount           `json:"comments"`
+	Media      ImportCount           `json:"media"`
+	Items      []WordPressImportItem `json:"items"`
+}
+
+type ImportCount struct {
+	Created int `json:"created"`
+	Skipped int `json:"skipped"`
+	Failed  int `json:"failed"`
+}
+
+type WordPressImportItem struct {
+	Kind   string             `json:"kind" example:"blog"`
+	Source string             `json:"source" example:"hello-world"`
+	Status enum.EImportStatus `json:"status"`
+	Reason string             `json:"reason"`
+}
@@ -1,5 +1,7 @@
 package model
 
+import "learn/fiber/pkg/enum"
+
 type ResponseEntity[T any] struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
@@ -13,6 +15,13 @@ type ResponseEntityPagination[T any] struct {
 	Meta    *MetaPagination `json:"meta,omitempty"`
 }
 
+type ResponseEntityCursor[T any] struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    T           `json:"data"`
+	Meta    *MetaCursor `json:"meta,omitempty"`
+}
+
 type MetaPagination struct {
 	Page      int `json:"page"`
 	Limit     int `json:"limit"`
@@ -20,13 +29,56 @@ type MetaPagination struct {
 	TotalData int `json:"totalData"`
 }
 
+// MetaCursor is returned by keyset paginated endpoints, the cursors are
+// opaque and should be passed back as is in the cursor query parameter.
+type MetaCursor struct {
+	Limit      int     `json:"limit"`
+	HasNext    bool    `json:"hasNext"`
+	HasPrev    bool    `json:"hasPrev"`
+	NextCursor *string `json:"nextCursor"`
+	PrevCursor *string `json:"prevCursor"`
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
 type ResponseError[T any] struct {
 	ResponseEntity[T]
 	Path string `json:"path"`
 }
 
+// Response and ErrorResponse are the envelopes without their data, for the
+// swag annotations of handlers that do not import this package. swag only
+// resolves a generic type through the imports of the annotated file, so those
+// handlers compose model.Response{data=res.SeriesResponse} instead of naming
+// model.ResponseEntity[res.SeriesResponse].
+type Response struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type ErrorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Path    string `json:"path"`
+}
+
 type PaginationRequest struct {
-	Page   int    `json:"page" query:"Page" validate:"required,min=1"`
-	Limit  int    `json:"limit" query:"Limit" validate:"required,min=1,max=100"`
+	Page    int     `json:"page" query:"Page" validate:"required,min=1"`
+	Limit   int     `json:"limit" query:"Limit" validate:"required,min=1,max=100"`
+	Search  string  `json:"search" query:"search" validate:"omitempty"`
+	Sort    string  `json:"sort" query:"sort" validate:"omitempty" example:"-createdAt,title"`
+	Filters Filters `json:"-" query:"-" swaggerignore:"true"`
+}
+
+// Filters holds filter[field][operator]=value query parameters keyed by
+// field and then operator, filter[field]=value is stored under eq.
+type Filters map[string]map[enum.EFilterOperator]string
+
+type CursorPaginationRequest struct {
+	Cursor string `json:"cursor" query:"cursor" validate:"omitempty"`
+	Limit  int    `json:"limit" query:"limit" validate:"omitempty,min=1,max=100"`
 	Search string `json:"search" query:"search" validate:"omitempty"`
 }
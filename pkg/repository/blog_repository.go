@@ -1,13 +1,73 @@
 package repository
 
 import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model"
 	"learn/fiber/pkg/model/entity"
 	"learn/fiber/pkg/model/res"
+	"learn/fiber/utils"
+	"slices"
 	"strings"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// blogColumns are the columns of res.FindBlogResponse, they expect blogs to
+// be aliased as b and its owner as u.
+const blogColumns = `
+            b.id,
+            b.title,
+            b.body,
+            b.image,
+            b.user_id,
+            b.language,
+            b.format,
+            b.hidden_at IS NOT NULL as hidden,
+            u.username as owner,
+            (
+                SELECT COUNT(*) FROM comments c
+                WHERE c.blog_id = b.id AND c.deleted_at IS NULL AND c.hidden_at IS NULL
+            ) as comment_count,
+            b.created_at,
+            b.updated_at`
+
+// blogSelectQuery selects res.FindBlogResponse rows, callers append their
+// own WHERE, ORDER BY and LIMIT clauses.
+const blogSelectQuery = `
+        SELECT` + blogColumns + `
+        FROM blogs b
+        JOIN users u ON b.user_id = u.id
+`
+
+// headlineMarks makes ts_headline delimit matches with control characters
+// instead of markup, the headline is escaped before they turn into <mark>.
+const headlineMarks = "StartSel=" + utils.HighlightStart + ", StopSel=" + utils.HighlightStop
+
+// blogVisibleCondition hides blogs in the trash from everyone and blogs taken
+// down by a moderator from everyone but their author, it expects the viewer
+// id as its argument.
+const blogVisibleCondition = "(b.deleted_at IS NULL AND (b.hidden_at IS NULL OR b.user_id = ?))"
+
+// BlogFilter narrows FindAllPagination, Tags and Categories hold slugs that
+// must all be attached to a blog for it to match. Filters and Sort are checked
+// against blogListSchema, Sort is ignored by FindAllCursor.
+type BlogFilter struct {
+	Search     string
+	Tags       []string
+	Categories []string
+	Filters    model.Filters
+	Sort       string
+}
+
+// RelatedBlog is a blog scored by FindRelated against another one.
+type RelatedBlog struct {
+	BlogId     string
+	SharedTags int
+	Score      float64
+}
+
 type BlogRepository struct {
 	db *gorm.DB
 }
@@ -16,76 +76,837 @@ func NewBlogRepository(db *gorm.DB) *BlogRepository {
 	return &BlogRepository{db: db}
 }
 
+// Create stores the blog together with its first revision.
 func (r *BlogRepository) Create(blog *entity.Blog) error {
-	return r.db.Create(blog).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Tags.*", "Categories.*").Create(blog).Error; err != nil {
+			return err
+		}
+
+		return createRevision(tx, blog, blog.UserId)
+	})
 }
 
-func (r *BlogRepository) FindAllPagination(page, limit int, search string) (*[]res.FindBlogResponse, int64, error) {
+// Update saves the blog and records the edit as a new revision. Blogs created
+// before revisions existed get their previous content snapshotted first so
+// the edit can still be undone.
+func (r *BlogRepository) Update(blog *entity.Blog, editorId string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var current entity.Blog
+
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, "id = ?", blog.Id).Error; err != nil {
+			return err
+		}
+
+		var revisions int64
+
+		if err := tx.Model(&entity.BlogRevision{}).Where("blog_id = ?", blog.Id).Count(&revisions).Error; err != nil {
+			return err
+		}
+
+		if revisions == 0 {
+			if err := createRevision(tx, &current, current.UserId); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Omit(clause.Associations).Save(blog).Error; err != nil {
+			return err
+		}
+
+		return createRevision(tx, blog, editorId)
+	})
+}
+
+// createRevision must run inside a transaction holding the blog row lock,
+// otherwise two concurrent edits could compute the same revision number.
+func createRevision(tx *gorm.DB, blog *entity.Blog, editorId string) error {
+	var number int
+
+	if err := tx.Raw(
+		"SELECT COALESCE(MAX(number), 0) + 1 FROM blog_revisions WHERE blog_id = ?",
+		blog.Id,
+	).Scan(&number).Error; err != nil {
+		return err
+	}
+
+	return tx.Create(&entity.BlogRevision{
+		BlogId:   blog.Id,
+		Number:   number,
+		Title:    blog.Title,
+		Body:     blog.Body,
+		Format:   blog.Format,
+		Image:    blog.Image,
+		EditorId: editorId,
+	}).Error
+}
+
+func (r *BlogRepository) FindAllPagination(page, limit int, filter BlogFilter, viewerId string) (*[]res.FindBlogResponse, int64, error) {
 	var blogs []res.FindBlogResponse = make([]res.FindBlogResponse, 0)
 	var total int64
 
-	search = "%" + strings.ToLower(search) + "%"
+	conditions, args, err := blogFilterConditions(filter)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	conditions = append(conditions, blogVisibleCondition)
+	args = append(args, viewerId)
+
+	orderBy, err := blogListSchema.orderBy(filter.Sort)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// A clause.OrderBy argument renders its own ORDER BY keyword.
+	order, orderArgs := "ORDER BY b.created_at DESC", []any{}
+
+	if orderBy != nil {
+		order, orderArgs = "?", []any{*orderBy}
+	}
+
+	where := strings.Join(conditions, " AND ")
 
 	queryCount := r.db.Raw(`
         SELECT COUNT(*) as total
         FROM blogs b
         JOIN users u ON b.user_id = u.id
-        WHERE
-            LOWER(b.title) LIKE ?
-            OR LOWER(b.body) LIKE ?
-            OR LOWER(u.username) LIKE ?
-    `, search, search, search)
+        WHERE `+where, args...)
 
 	if err := queryCount.Scan(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	query := r.db.Raw(`
-        SELECT
-            b.id,
-            b.title,
-            b.body,
-            b.image,
-            b.user_id,
-            u.username as owner,
-            b.created_at,
-            b.updated_at
-        FROM blogs b
-        JOIN users u ON b.user_id = u.id
-        WHERE
-            LOWER(b.title) LIKE ?
-            OR LOWER(b.body) LIKE ?
-            OR LOWER(u.username) LIKE ?
-        ORDER BY b.created_at DESC
+	query := r.db.Raw(blogSelectQuery+`
+        WHERE `+where+`
+        `+order+`
         LIMIT ? OFFSET ?
-    `, search, search, search, limit, (page-1)*limit)
+    `, append(append(args, orderArgs...), limit, (page-1)*limit)...)
 
 	if err := query.Scan(&blogs).Error; err != nil {
 		return nil, 0, err
 	}
 
+	if err := r.attachRelations(blogPointers(blogs), viewerId); err != nil {
+		return nil, 0, err
+	}
+
 	return &blogs, total, nil
 }
 
-func (r *BlogRepository) FindById(id string) (*res.FindBlogResponse, error) {
+// FindAllCursor pages through blogs newest first without counting them, the
+// cursor is the (created_at, id) of the row next to the wanted page. One extra
+// row is fetched to tell whether more rows follow in the reading direction.
+func (r *BlogRepository) FindAllCursor(limit int, filter BlogFilter, cursor *utils.Cursor, viewerId string) ([]res.FindBlogResponse, bool, error) {
+	var blogs []res.FindBlogResponse = make([]res.FindBlogResponse, 0)
+
+	conditions, args, err := blogFilterConditions(filter)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	conditions = append(conditions, blogVisibleCondition)
+	args = append(args, viewerId)
+	order := "b.created_at DESC, b.id DESC"
+
+	if cursor != nil {
+		if cursor.Backward {
+			conditions = append(conditions, "(b.created_at, b.id) > (?, ?)")
+			order = "b.created_at ASC, b.id ASC"
+		} else {
+			conditions = append(conditions, "(b.created_at, b.id) < (?, ?)")
+		}
+
+		args = append(args, cursor.CreatedAt, cursor.Id)
+	}
+
+	query := r.db.Raw(blogSelectQuery+`
+        WHERE `+strings.Join(conditions, " AND ")+`
+        ORDER BY `+order+`
+        LIMIT ?
+    `, append(args, limit+1)...)
+
+	if err := query.Scan(&blogs).Error; err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(blogs) > limit
+
+	if hasMore {
+		blogs = blogs[:limit]
+	}
+
+	if cursor != nil && cursor.Backward {
+		slices.Reverse(blogs)
+	}
+
+	if err := r.attachRelations(blogPointers(blogs), viewerId); err != nil {
+		return nil, false, err
+	}
+
+	return blogs, hasMore, nil
+}
+
+func (r *BlogRepository) FindById(id, viewerId string) (*res.FindBlogResponse, error) {
 	var blog res.FindBlogResponse
 
-	if row := r.db.Raw(`
+	if row := r.db.Raw(blogSelectQuery+`
+        WHERE b.id = ? AND `+blogVisibleCondition+`
+    `, id, viewerId).Scan(&blog).RowsAffected; row == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	if err := r.attachRelations([]*res.FindBlogResponse{&blog}, viewerId); err != nil {
+		return nil, err
+	}
+
+	series, err := loadSeriesNavigation(r.db, blog.ID, viewerId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	blog.Series = series
+
+	return &blog, nil
+}
+
+// FindBySeries returns the posts of a series in reading order.
+func (r *BlogRepository) FindBySeries(seriesId, viewerId string) ([]res.FindBlogResponse, error) {
+	var blogs []res.FindBlogResponse = make([]res.FindBlogResponse, 0)
+
+	if err := r.db.Raw(blogSelectQuery+`
+        JOIN series_blogs sb ON sb.blog_id = b.id
+        WHERE sb.series_id = ? AND `+blogVisibleCondition+`
+        ORDER BY sb.position ASC
+    `, seriesId, viewerId).Scan(&blogs).Error; err != nil {
+		return nil, err
+	}
+
+	if err := r.attachRelations(blogPointers(blogs), viewerId); err != nil {
+		return nil, err
+	}
+
+	return blogs, nil
+}
+
+// FindRelated scores live blogs against a blog: two points per shared tag,
+// one per shared category, up to three for how alike the titles are (pg_trgm)
+// and the ts_rank of the blog against the words of the title. Only blogs that
+// share a tag or are close by text are scored, hidden blogs are left out.
+func (r *BlogRepository) FindRelated(blogId string, excludeAuthor bool, limit int) ([]RelatedBlog, error) {
+	var related []RelatedBlog = make([]RelatedBlog, 0)
+
+	if err := r.db.Raw(`
+        WITH s AS (
+            SELECT id, user_id, title,
+                replace(plainto_tsquery(language, title)::text, '&', '|')::tsquery as terms
+            FROM blogs
+            WHERE id = ? AND deleted_at IS NULL
+        ),
+        candidates AS (
+            SELECT
+                b.id,
+                b.created_at,
+                (
+                    SELECT COUNT(*) FROM blog_tags bt
+                    JOIN blog_tags st ON st.tag_id = bt.tag_id AND st.blog_id = s.id
+                    WHERE bt.blog_id = b.id
+                ) as shared_tags,
+                (
+                    SELECT COUNT(*) FROM blog_categories bc
+                    JOIN blog_categories sc ON sc.category_id = bc.category_id AND sc.blog_id = s.id
+                    WHERE bc.blog_id = b.id
+                ) as shared_categories,
+                similarity(b.title, s.title) as title_similarity,
+                ts_rank(b.search_vector, s.terms) as text_rank
+            FROM blogs b
+            CROSS JOIN s
+            WHERE b.id <> s.id AND b.deleted_at IS NULL AND b.hidden_at IS NULL
+                AND (NOT ? OR b.user_id <> s.user_id)
+                AND (
+                    EXISTS (
+                        SELECT 1 FROM blog_tags bt
+                        JOIN blog_tags st ON st.tag_id = bt.tag_id AND st.blog_id = s.id
+                        WHERE bt.blog_id = b.id
+                    )
+                    OR b.title % s.title
+                    OR b.search_vector @@ s.terms
+                )
+        )
+        SELECT
+            id as blog_id,
+            shared_tags,
+            2 * shared_tags + shared_categories + 3 * title_similarity + text_rank as score
+        FROM candidates
+        ORDER BY score DESC, created_at DESC
+        LIMIT ?
+    `, blogId, excludeAuthor, limit).Scan(&related).Error; err != nil {
+		return nil, err
+	}
+
+	return related, nil
+}
+
+// FindByIds returns the blogs among ids the viewer can see, in the order of
+// ids.
+func (r *BlogRepository) FindByIds(ids []string, viewerId string) ([]res.FindBlogResponse, error) {
+	var found []res.FindBlogResponse
+	var blogs []res.FindBlogResponse = make([]res.FindBlogResponse, 0, len(ids))
+
+	if len(ids) == 0 {
+		return blogs, nil
+	}
+
+	if err := r.db.Raw(blogSelectQuery+`
+        WHERE b.id IN ? AND `+blogVisibleCondition+`
+    `, ids, viewerId).Scan(&found).Error; err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		index := slices.IndexFunc(found, func(blog res.FindBlogResponse) bool { return blog.ID == id })
+
+		if index >= 0 {
+			blogs = append(blogs, found[index])
+		}
+	}
+
+	if err := r.attachRelations(blogPointers(blogs), viewerId); err != nil {
+		return nil, err
+	}
+
+	return blogs, nil
+}
+
+// LastChangedAt is when a blog was last created, edited, deleted or hidden.
+// Unhiding and restoring bump updated_at, so they count too.
+func (r *BlogRepository) LastChangedAt() (time.Time, error) {
+	var changedAt time.Time
+
+	if err := r.db.Raw(`
+        SELECT COALESCE(GREATEST(MAX(updated_at), MAX(deleted_at), MAX(hidden_at)), 'epoch')
+        FROM blogs
+    `).Row().Scan(&changedAt); err != nil {
+		return time.Time{}, err
+	}
+
+	return changedAt, nil
+}
+
+// Search ranks blogs matching a web search style query such as
+// `fiber -express "rest api"`. Without a language the query is parsed with
+// every supported configuration so blogs in any language can match, the
+// tsquery stays constant either way so the GIN index on search_vector is used.
+func (r *BlogRepository) Search(query string, language enum.ELanguage, page, limit int, viewerId string) ([]res.SearchBlogResponse, int64, error) {
+	var blogs []res.SearchBlogResponse = make([]res.SearchBlogResponse, 0)
+	var total int64
+
+	tsQuery := `websearch_to_tsquery(?::regconfig, ?)`
+	args := []any{language, query}
+	where := "b.search_vector @@ q.query AND b.language = ?::regconfig"
+
+	if language == "" {
+		tsQuery = `websearch_to_tsquery('english', ?)
+                || websearch_to_tsquery('indonesian', ?)
+                || websearch_to_tsquery('simple', ?)`
+		args = []any{query, query, query}
+		where = "b.search_vector @@ q.query"
+	}
+
+	withQuery := `
+        WITH q AS (SELECT ` + tsQuery + ` as query)`
+
+	where += " AND " + blogVisibleCondition
+	whereArgs := args
+
+	if language != "" {
+		whereArgs = append(whereArgs, language)
+	}
+
+	whereArgs = append(whereArgs, viewerId)
+
+	if err := r.db.Raw(withQuery+`
+        SELECT COUNT(*) as total
+        FROM blogs b
+        CROSS JOIN q
+        WHERE `+where, whereArgs...).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.db.Raw(withQuery+`
+        SELECT`+blogColumns+`,
+            ts_rank(b.search_vector, q.query) as rank,
+            ts_headline(b.language, b.title, q.query, 'HighlightAll=true, `+headlineMarks+`') as title_highlight,
+            ts_headline(b.language, b.body, q.query, 'MaxFragments=2, MaxWords=30, MinWords=10, `+headlineMarks+`') as snippet
+        FROM blogs b
+        JOIN users u ON b.user_id = u.id
+        CROSS JOIN q
+        WHERE `+where+`
+        ORDER BY rank DESC, b.created_at DESC
+        LIMIT ? OFFSET ?
+    `, append(whereArgs, limit, (page-1)*limit)...).Scan(&blogs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	pointers := make([]*res.FindBlogResponse, 0, len(blogs))
+
+	for i := range blogs {
+		blogs[i].TitleHighlight = utils.HighlightMatches(blogs[i].TitleHighlight)
+		blogs[i].Snippet = utils.HighlightMatches(blogs[i].Snippet)
+		pointers = append(pointers, &blogs[i].FindBlogResponse)
+	}
+
+	if err := r.attachRelations(pointers, viewerId); err != nil {
+		return nil, 0, err
+	}
+
+	return blogs, total, nil
+}
+
+// FindFeed returns the latest blogs for syndication, optionally limited to
+// one author and one tag slug.
+func (r *BlogRepository) FindFeed(limit int, authorId, tag string) ([]res.FeedItem, error) {
+	var items []res.FeedItem = make([]res.FeedItem, 0)
+
+	conditions := []string{"b.deleted_at IS NULL", "b.hidden_at IS NULL"}
+	args := []any{}
+
+	if authorId != "" {
+		conditions = append(conditions, "b.user_id = ?")
+		args = append(args, authorId)
+	}
+
+	if tag != "" {
+		conditions = append(conditions, `EXISTS (
+            SELECT 1 FROM blog_tags bt
+            JOIN tags t ON t.id = bt.tag_id
+            WHERE bt.blog_id = b.id AND t.slug = ? AND t.deleted_at IS NULL
+        )`)
+		args = append(args, tag)
+	}
+
+	if err := r.db.Raw(`
         SELECT
             b.id,
             b.title,
             b.body,
             b.image,
-            b.user_id,
-            u.username as owner,
+            u.username as author,
             b.created_at,
             b.updated_at
         FROM blogs b
         JOIN users u ON b.user_id = u.id
-        WHERE b.id = ?
-    `, id).Scan(&blog).RowsAffected; row == 0 {
+        WHERE `+strings.Join(conditions, " AND ")+`
+        ORDER BY b.created_at DESC
+        LIMIT ?
+    `, append(args, limit)...).Scan(&items).Error; err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return items, nil
+	}
+
+	ids := make([]string, 0, len(items))
+	index := make(map[string]int, len(items))
+
+	for i := range items {
+		ids = append(ids, items[i].Id)
+		index[items[i].Id] = i
+		items[i].Tags = []string{}
+	}
+
+	var tags []struct {
+		BlogId string
+		Name   string
+	}
+
+	if err := r.db.Raw(`
+        SELECT bt.blog_id, t.name
+        FROM blog_tags bt
+        JOIN tags t ON t.id = bt.tag_id
+        WHERE bt.blog_id IN ? AND t.deleted_at IS NULL
+        ORDER BY t.name ASC
+    `, ids).Scan(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	for _, tag := range tags {
+		i := index[tag.BlogId]
+		items[i].Tags = append(items[i].Tags, tag.Name)
+	}
+
+	return items, nil
+}
+
+// FindFollowingFeed pages through the blogs of the authors userId follows,
+// newest first. The follows join is driven by its primary key.
+func (r *BlogRepository) FindFollowingFeed(userId string, page, limit int, viewerId string) ([]res.FindBlogResponse, int64, error) {
+	var blogs []res.FindBlogResponse = make([]res.FindBlogResponse, 0)
+	var total int64
+
+	if err := r.db.Raw(`
+        SELECT COUNT(*) as total
+        FROM blogs b
+        JOIN follows f ON f.following_id = b.user_id AND f.follower_id = ?
+        WHERE b.deleted_at IS NULL AND b.hidden_at IS NULL
+    `, userId).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.db.Raw(blogSelectQuery+`
+        JOIN follows f ON f.following_id = b.user_id AND f.follower_id = ?
+        WHERE b.deleted_at IS NULL AND b.hidden_at IS NULL
+        ORDER BY b.created_at DESC, b.id DESC
+        LIMIT ? OFFSET ?
+    `, userId, limit, (page-1)*limit).Scan(&blogs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.attachRelations(blogPointers(blogs), viewerId); err != nil {
+		return nil, 0, err
+	}
+
+	return blogs, total, nil
+}
+
+// FindBookmarked pages through the blogs userId bookmarked, most recently
+// bookmarked first, optionally only those in one folder.
+func (r *BlogRepository) FindBookmarked(userId, folderId string, page, limit int) ([]res.FindBlogResponse, int64, error) {
+	var blogs []res.FindBlogResponse = make([]res.FindBlogResponse, 0)
+	var total int64
+
+	join := "JOIN bookmarks bm ON bm.blog_id = b.id AND bm.user_id = ?"
+	args := []any{userId}
+
+	if folderId != "" {
+		join += " AND bm.folder_id = ?"
+		args = append(args, folderId)
+	}
+
+	if err := r.db.Raw(`
+        SELECT COUNT(*) as total
+        FROM blogs b
+        `+join+`
+        WHERE `+blogVisibleCondition+`
+    `, append(args, userId)...).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.db.Raw(blogSelectQuery+`
+        `+join+`
+        WHERE `+blogVisibleCondition+`
+        ORDER BY bm.created_at DESC
+        LIMIT ? OFFSET ?
+    `, append(args, userId, limit, (page-1)*limit)...).Scan(&blogs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.attachRelations(blogPointers(blogs), userId); err != nil {
+		return nil, 0, err
+	}
+
+	return blogs, total, nil
+}
+
+// ExistsByTitle reports whether the user already has a blog with the same
+// title ignoring case, excludeId skips the blog being edited.
+func (r *BlogRepository) ExistsByTitle(userId, title, excludeId string) (bool, error) {
+	var total int64
+
+	if err := r.db.Model(&entity.Blog{}).
+		Where("user_id = ? AND LOWER(title) = LOWER(?) AND id <> ?", userId, title, excludeId).
+		Count(&total).Error; err != nil {
+		return false, err
+	}
+
+	return total > 0, nil
+}
+
+// FindContributed lists the blogs a user wrote or contributed to, newest
+// first, optionally only those where the user has role.
+func (r *BlogRepository) FindContributed(userId string, role enum.EContributorRole, page, limit int, viewerId string) ([]res.FindBlogResponse, int64, error) {
+	var blogs []res.FindBlogResponse = make([]res.FindBlogResponse, 0)
+	var total int64
+
+	var where string
+	var args []any
+
+	switch role {
+	case "":
+		where = "(b.user_id = ? OR EXISTS (SELECT 1 FROM blog_contributors bc WHERE bc.blog_id = b.id AND bc.user_id = ?))"
+		args = []any{userId, userId}
+	case enum.CONTRIBUTOR_ROLE_AUTHOR:
+		where = "b.user_id = ?"
+		args = []any{userId}
+	default:
+		where = "EXISTS (SELECT 1 FROM blog_contributors bc WHERE bc.blog_id = b.id AND bc.user_id = ? AND bc.role = ?)"
+		args = []any{userId, role}
+	}
+
+	if err := r.db.Raw(`
+        SELECT COUNT(*) as total
+        FROM blogs b
+        WHERE `+blogVisibleCondition+` AND `+where+`
+    `, append([]any{viewerId}, args...)...).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.db.Raw(blogSelectQuery+`
+        WHERE `+blogVisibleCondition+` AND `+where+`
+        ORDER BY b.created_at DESC
+        LIMIT ? OFFSET ?
+    `, append(append([]any{viewerId}, args...), limit, (page-1)*limit)...).Scan(&blogs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.attachRelations(blogPointers(blogs), viewerId); err != nil {
+		return nil, 0, err
+	}
+
+	return blogs, total, nil
+}
+
+// FindIdsByUser returns the ids among ids that belong to live blogs of the
+// user.
+func (r *BlogRepository) FindIdsByUser(userId string, ids []string) ([]string, error) {
+	var owned []string
+
+	if len(ids) == 0 {
+		return owned, nil
+	}
+
+	if err := r.db.Model(&entity.Blog{}).
+		Where("user_id = ? AND id IN ?", userId, ids).
+		Pluck("id", &owned).Error; err != nil {
+		return nil, err
+	}
+
+	return owned, nil
+}
+
+// FindAllByUser loads every blog of a user with its tags and categories,
+// oldest first, for exporting them.
+func (r *BlogRepository) FindAllByUser(userId string) ([]entity.Blog, error) {
+	var blogs []entity.Blog
+
+	if err := r.db.
+		Preload("Tags", func(db *gorm.DB) *gorm.DB { return db.Order("name ASC") }).
+		Preload("Categories", func(db *gorm.DB) *gorm.DB { return db.Order("name ASC") }).
+		Where("user_id = ?", userId).
+		Order("created_at ASC").
+		Find(&blogs).Error; err != nil {
+		return nil, err
+	}
+
+	return blogs, nil
+}
+
+// ReplaceTaxonomy sets the tags and categories of a blog to exactly the given
+// ones.
+func (r *BlogRepository) ReplaceTaxonomy(blogId string, tags []entity.Tag, categories []entity.Category) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM blog_tags WHERE blog_id = ?", blogId).Error; err != nil {
+			return err
+		}
+
+		for _, tag := range tags {
+			if err := tx.Exec("INSERT INTO blog_tags (blog_id, tag_id) VALUES (?, ?)", blogId, tag.Id).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Exec("DELETE FROM blog_categories WHERE blog_id = ?", blogId).Error; err != nil {
+			return err
+		}
+
+		for _, category := range categories {
+			if err := tx.Exec("INSERT INTO blog_categories (blog_id, category_id) VALUES (?, ?)", blogId, category.Id).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *BlogRepository) FindEntityById(id string) (*entity.Blog, error) {
+	var blog entity.Blog
+
+	if err := r.db.Preload("Contributors").First(&blog, "id = ?", id).Error; err != nil {
 		return nil, gorm.ErrRecordNotFound
 	}
 
 	return &blog, nil
 }
+
+// attachRelations loads the tags, categories and reactions of every blog in
+// one query each, instead of one query per blog. Bodies are rendered here too
+// so a stricter sanitizer policy applies to blogs written before it.
+func (r *BlogRepository) attachRelations(blogs []*res.FindBlogResponse, viewerId string) error {
+	if len(blogs) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(blogs))
+	index := make(map[string]int, len(blogs))
+
+	for i := range blogs {
+		ids = append(ids, blogs[i].ID)
+		index[blogs[i].ID] = i
+		blogs[i].Tags = []res.TagResponse{}
+		blogs[i].Categories = []res.CategoryResponse{}
+		blogs[i].Authors = []res.ContributorResponse{{
+			UserId:   blogs[i].UserId,
+			Username: blogs[i].Owner,
+			Role:     enum.CONTRIBUTOR_ROLE_AUTHOR,
+		}}
+
+		rendered, err := utils.RenderBody(blogs[i].Body, enum.EBlogFormat(blogs[i].Format))
+
+		if err != nil {
+			return err
+		}
+
+		blogs[i].BodyHtml = rendered.Html
+		blogs[i].Toc = rendered.Toc
+		blogs[i].ReadingTime = rendered.ReadingTime
+	}
+
+	var tags []struct {
+		BlogId string
+		res.TagResponse
+	}
+
+	if err := r.db.Raw(`
+        SELECT bt.blog_id, t.id, t.name, t.slug
+        FROM blog_tags bt
+        JOIN tags t ON t.id = bt.tag_id
+        WHERE bt.blog_id IN ? AND t.deleted_at IS NULL
+        ORDER BY t.name ASC
+    `, ids).Scan(&tags).Error; err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		i := index[tag.BlogId]
+		blogs[i].Tags = append(blogs[i].Tags, tag.TagResponse)
+	}
+
+	var categories []struct {
+		BlogId string
+		res.CategoryResponse
+	}
+
+	if err := r.db.Raw(`
+        SELECT bc.blog_id, c.id, c.name, c.slug, c.description
+        FROM blog_categories bc
+        JOIN categories c ON c.id = bc.category_id
+        WHERE bc.blog_id IN ? AND c.deleted_at IS NULL
+        ORDER BY c.name ASC
+    `, ids).Scan(&categories).Error; err != nil {
+		return err
+	}
+
+	for _, category := range categories {
+		i := index[category.BlogId]
+		blogs[i].Categories = append(blogs[i].Categories, category.CategoryResponse)
+	}
+
+	var coAuthors []struct {
+		BlogId string
+		res.ContributorResponse
+	}
+
+	if err := r.db.Raw(`
+        SELECT bc.blog_id, bc.user_id, u.username, bc.role
+        FROM blog_contributors bc
+        JOIN users u ON u.id = bc.user_id
+        WHERE bc.blog_id IN ? AND bc.role = ? AND u.deleted_at IS NULL
+        ORDER BY bc.created_at ASC
+    `, ids, enum.CONTRIBUTOR_ROLE_CO_AUTHOR).Scan(&coAuthors).Error; err != nil {
+		return err
+	}
+
+	for _, coAuthor := range coAuthors {
+		i := index[coAuthor.BlogId]
+		blogs[i].Authors = append(blogs[i].Authors, coAuthor.ContributorResponse)
+	}
+
+	reactions, err := loadReactionSummaries(r.db, enum.REACTION_TARGET_BLOG, ids, viewerId)
+
+	if err != nil {
+		return err
+	}
+
+	bookmarked, err := loadBookmarked(r.db, ids, viewerId)
+
+	if err != nil {
+		return err
+	}
+
+	for i := range blogs {
+		blogs[i].ReactionSummary = reactions[blogs[i].ID]
+		blogs[i].Bookmarked = bookmarked[blogs[i].ID]
+	}
+
+	return nil
+}
+
+func blogPointers(blogs []res.FindBlogResponse) []*res.FindBlogResponse {
+	pointers := make([]*res.FindBlogResponse, 0, len(blogs))
+
+	for i := range blogs {
+		pointers = append(pointers, &blogs[i])
+	}
+
+	return pointers
+}
+
+// blogFilterConditions turns a BlogFilter into conditions that must all hold,
+// together with their arguments in order.
+func blogFilterConditions(filter BlogFilter) ([]string, []any, error) {
+	search := "%" + strings.ToLower(filter.Search) + "%"
+
+	conditions := []string{`(
+            LOWER(b.title) LIKE ?
+            OR LOWER(b.body) LIKE ?
+            OR LOWER(u.username) LIKE ?
+        )`}
+	args := []any{search, search, search}
+
+	for _, tag := range filter.Tags {
+		conditions = append(conditions, `EXISTS (
+            SELECT 1 FROM blog_tags bt
+            JOIN tags t ON t.id = bt.tag_id
+            WHERE bt.blog_id = b.id AND t.slug = ?
+        )`)
+		args = append(args, tag)
+	}
+
+	for _, category := range filter.Categories {
+		conditions = append(conditions, `EXISTS (
+            SELECT 1 FROM blog_categories bc
+            JOIN categories c ON c.id = bc.category_id
+            WHERE bc.blog_id = b.id AND c.slug = ?
+        )`)
+		args = append(args, category)
+	}
+
+	expressions, err := blogListSchema.where(filter.Filters)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, expression := range expressions {
+		conditions = append(conditions, "?")
+		args = append(args, expression)
+	}
+
+	return conditions, args, nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/res"
+
+	"gorm.io/gorm"
+)
+
+type BlogRevisionRepository struct {
+	db *gorm.DB
+}
+
+func NewBlogRevisionRepository(db *gorm.DB) *BlogRevisionRepository {
+	return &BlogRevisionRepository{db: db}
+}
+
+func (r *BlogRevisionRepository) FindAllPagination(blogId string, page, limit int) ([]res.BlogRevisionResponse, int64, error) {
+	var revisions []res.BlogRevisionResponse = make([]res.BlogRevisionResponse, 0)
+	var total int64
+
+	if err := r.db.Model(&entity.BlogRevision{}).Where("blog_id = ?", blogId).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.db.Raw(`
+        SELECT
+            r.number,
+            r.title,
+            r.format,
+            r.image,
+            r.editor_id,
+            u.username as editor,
+            r.created_at
+        FROM blog_revisions r
+        JOIN users u ON r.editor_id = u.id
+        WHERE r.blog_id = ?
+        ORDER BY r.number DESC
+        LIMIT ? OFFSET ?
+    `, blogId, limit, (page-1)*limit).Scan(&revisions).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return revisions, total, nil
+}
+
+func (r *BlogRevisionRepository) FindByNumber(blogId string, number int) (*res.BlogRevisionDetailResponse, error) {
+	var revision res.BlogRevisionDetailResponse
+
+	if row := r.db.Raw(`
+        SELECT
+            r.number,
+            r.title,
+            r.body,
+            r.format,
+            r.image,
+            r.editor_id,
+            u.username as editor,
+            r.created_at
+        FROM blog_revisions r
+        JOIN users u ON r.editor_id = u.id
+        WHERE r.blog_id = ? AND r.number = ?
+    `, blogId, number).Scan(&revision).RowsAffected; row == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &revision, nil
+}
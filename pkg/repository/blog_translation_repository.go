@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BlogTranslationRepository struct {
+	db *gorm.DB
+}
+
+func NewBlogTranslationRepository(db *gorm.DB) *BlogTranslationRepository {
+	return &BlogTranslationRepository{db: db}
+}
+
+// Save adds the translation or replaces the one the blog has in its locale.
+func (r *BlogTranslationRepository) Save(translation *entity.BlogTranslation) error {
+	return r.db.Omit("Blog").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "blog_id"}, {Name: "locale"}},
+		DoUpdates: clause.AssignmentColumns([]string{"title", "body", "slug", "updated_at"}),
+	}).Create(translation).Error
+}
+
+func (r *BlogTranslationRepository) FindByBlogAndLocale(blogId string, locale enum.ELocale) (*entity.BlogTranslation, error) {
+	var translation entity.BlogTranslation
+
+	if err := r.db.First(&translation, "blog_id = ? AND locale = ?", blogId, locale).Error; err != nil {
+		return nil, err
+	}
+
+	return &translation, nil
+}
+
+func (r *BlogTranslationRepository) FindByBlogs(blogIds []string) ([]entity.BlogTranslation, error) {
+	var translations []entity.BlogTranslation = make([]entity.BlogTranslation, 0)
+
+	if len(blogIds) == 0 {
+		return translations, nil
+	}
+
+	if err := r.db.Where("blog_id IN ?", blogIds).Order("locale ASC").Find(&translations).Error; err != nil {
+		return nil, err
+	}
+
+	return translations, nil
+}
+
+func (r *BlogTranslationRepository) Delete(blogId string, locale enum.ELocale) (bool, error) {
+	result := r.db.Where("blog_id = ? AND locale = ?", blogId, locale).Delete(&entity.BlogTranslation{})
+
+	return result.RowsAffected > 0, result.Error
+}
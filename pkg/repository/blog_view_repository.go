@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/res"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BlogViewBatch is a flush of the view tracker, already aggregated per blog
+// and day.
+type BlogViewBatch struct {
+	Stats     []entity.BlogViewStat
+	Referrers []entity.BlogViewReferrer
+	Visitors  []entity.BlogViewVisitor
+}
+
+type BlogViewRepository struct {
+	db *gorm.DB
+}
+
+func NewBlogViewRepository(db *gorm.DB) *BlogViewRepository {
+	return &BlogViewRepository{db: db}
+}
+
+// SaveBatch adds a batch onto the daily aggregates in one transaction.
+// Visitors are written first so unique visitors of an existing day can be
+// recounted from them, a new day already carries the count of the batch.
+func (r *BlogViewRepository) SaveBatch(batch *BlogViewBatch) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if len(batch.Visitors) > 0 {
+			if err := tx.Omit("Blog").Clauses(clause.OnConflict{DoNothing: true}).Create(&batch.Visitors).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(batch.Stats) > 0 {
+			err := tx.Omit("Blog").Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "blog_id"}, {Name: "day"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"views": gorm.Expr("blog_view_stats.views + EXCLUDED.views"),
+					"unique_visitors": gorm.Expr(
+						"(SELECT COUNT(*) FROM blog_view_visitors v WHERE v.blog_id = EXCLUDED.blog_id AND v.day = EXCLUDED.day)",
+					),
+				}),
+			}).Create(&batch.Stats).Error
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(batch.Referrers) > 0 {
+			err := tx.Omit("Blog").Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "blog_id"}, {Name: "day"}, {Name: "referrer"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"views": gorm.Expr("blog_view_referrers.views + EXCLUDED.views"),
+				}),
+			}).Create(&batch.Referrers).Error
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *BlogViewRepository) FindDaily(blogId string, from, to time.Time) ([]entity.BlogViewStat, error) {
+	var stats []entity.BlogViewStat
+
+	err := r.db.
+		Where("blog_id = ? AND day BETWEEN ? AND ?", blogId, from, to).
+		Order("day ASC").
+		Find(&stats).Error
+
+	return stats, err
+}
+
+// CountUniqueVisitors counts a visitor once over the whole range, summing
+// the daily counts would count returning visitors once per day.
+func (r *BlogViewRepository) CountUniqueVisitors(blogId string, from, to time.Time) (int64, error) {
+	var total int64
+
+	err := r.db.Model(&entity.BlogViewVisitor{}).
+		Where("blog_id = ? AND day BETWEEN ? AND ?", blogId, from, to).
+		Distinct("visitor").
+		Count(&total).Error
+
+	return total, err
+}
+
+func (r *BlogViewRepository) FindTopReferrers(blogId string, from, to time.Time, limit int) ([]res.BlogReferrerResponse, error) {
+	var referrers []res.BlogReferrerResponse = make([]res.BlogReferrerResponse, 0)
+
+	if err := r.db.Raw(`
+        SELECT
+            r.referrer,
+            SUM(r.views) AS views
+        FROM blog_view_referrers r
+        WHERE r.blog_id = ? AND r.day BETWEEN ? AND ?
+        GROUP BY r.referrer
+        ORDER BY views DESC, r.referrer ASC
+        LIMIT ?
+    `, blogId, from, to, limit).Scan(&referrers).Error; err != nil {
+		return nil, err
+	}
+
+	return referrers, nil
+}
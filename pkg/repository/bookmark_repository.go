@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/res"
+
+	"gorm.io/gorm"
+)
+
+type BookmarkRepository struct {
+	db *gorm.DB
+}
+
+func NewBookmarkRepository(db *gorm.DB) *BookmarkRepository {
+	return &BookmarkRepository{db: db}
+}
+
+func (r *BookmarkRepository) FindByUserAndBlog(userId, blogId string) (*entity.Bookmark, error) {
+	var bookmark entity.Bookmark
+
+	if err := r.db.First(&bookmark, "user_id = ? AND blog_id = ?", userId, blogId).Error; err != nil {
+		return nil, err
+	}
+
+	return &bookmark, nil
+}
+
+func (r *BookmarkRepository) Create(bookmark *entity.Bookmark) error {
+	return r.db.Omit("User", "Blog", "Folder").Create(bookmark).Error
+}
+
+func (r *BookmarkRepository) MoveToFolder(userId, blogId string, folderId *string) error {
+	return r.db.Model(&entity.Bookmark{}).
+		Where("user_id = ? AND blog_id = ?", userId, blogId).
+		Update("folder_id", folderId).Error
+}
+
+func (r *BookmarkRepository) Delete(userId, blogId string) error {
+	return r.db.Where("user_id = ? AND blog_id = ?", userId, blogId).Delete(&entity.Bookmark{}).Error
+}
+
+func (r *BookmarkRepository) CreateFolder(folder *entity.BookmarkFolder) error {
+	return r.db.Create(folder).Error
+}
+
+func (r *BookmarkRepository) FindFolderById(id string) (*entity.BookmarkFolder, error) {
+	var folder entity.BookmarkFolder
+
+	if err := r.db.First(&folder, "id = ?", id).Error; err != nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &folder, nil
+}
+
+func (r *BookmarkRepository) FindFolders(userId string) ([]res.BookmarkFolderResponse, error) {
+	var folders []res.BookmarkFolderResponse = make([]res.BookmarkFolderResponse, 0)
+
+	if err := r.db.Raw(`
+        SELECT
+            f.id,
+            f.name,
+            COUNT(bm.blog_id) as bookmark_count,
+            f.created_at
+        FROM bookmark_folders f
+        LEFT JOIN bookmarks bm ON bm.folder_id = f.id
+        WHERE f.user_id = ? AND f.deleted_at IS NULL
+        GROUP BY f.id
+        ORDER BY f.name ASC
+    `, userId).Scan(&folders).Error; err != nil {
+		return nil, err
+	}
+
+	return folders, nil
+}
+
+// ExistsFolderName reports whether the user has another folder with the same
+// name ignoring case, excludeId skips the folder being renamed.
+func (r *BookmarkRepository) ExistsFolderName(userId, name, excludeId string) (bool, error) {
+	var total int64
+
+	if err := r.db.Model(&entity.BookmarkFolder{}).
+		Where("user_id = ? AND LOWER(name) = LOWER(?) AND id <> ?", userId, name, excludeId).
+		Count(&total).Error; err != nil {
+		return false, err
+	}
+
+	return total > 0, nil
+}
+
+func (r *BookmarkRepository) UpdateFolder(folder *entity.BookmarkFolder) error {
+	return r.db.Save(folder).Error
+}
+
+// DeleteFolder keeps the bookmarks of the folder and moves them out of it.
+func (r *BookmarkRepository) DeleteFolder(id string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.Bookmark{}).Where("folder_id = ?", id).Update("folder_id", nil).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Where("id = ?", id).Delete(&entity.BookmarkFolder{}).Error
+	})
+}
+
+// loadBookmarked returns the subset of blogIds the viewer bookmarked.
+func loadBookmarked(db *gorm.DB, blogIds []string, viewerId string) (map[string]bool, error) {
+	bookmarked := map[string]bool{}
+
+	if viewerId == "" || len(blogIds) == 0 {
+		return bookmarked, nil
+	}
+
+	var ids []string
+
+	if err := db.Raw(`
+        SELECT blog_id FROM bookmarks
+        WHERE user_id = ? AND blog_id IN ?
+    `, viewerId, blogIds).Scan(&ids).Error; err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		bookmarked[id] = true
+	}
+
+	return bookmarked, nil
+}
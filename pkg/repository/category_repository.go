@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/res"
+
+	"gorm.io/gorm"
+)
+
+type CategoryRepository struct {
+	db *gorm.DB
+}
+
+func NewCategoryRepository(db *gorm.DB) *CategoryRepository {
+	return &CategoryRepository{db: db}
+}
+
+func (r *CategoryRepository) Create(category *entity.Category) error {
+	return r.db.Create(category).Error
+}
+
+// FindAllWithCount counts blogs with the same rules as the tag counts.
+func (r *CategoryRepository) FindAllWithCount() ([]res.CategoryWithCountResponse, error) {
+	var categories []res.CategoryWithCountResponse = make([]res.CategoryWithCountResponse, 0)
+
+	if err := r.db.Raw(`
+        SELECT
+            c.id,
+            c.name,
+            c.slug,
+            c.description,
+            COUNT(b.id) as post_count
+        FROM categories c
+        LEFT JOIN blog_categories bc ON bc.category_id = c.id
+        LEFT JOIN blogs b ON b.id = bc.blog_id AND b.deleted_at IS NULL AND b.hidden_at IS NULL
+        WHERE c.deleted_at IS NULL
+        GROUP BY c.id, c.name, c.slug, c.description
+        ORDER BY c.name ASC
+    `).Scan(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *CategoryRepository) FindById(id string) (*entity.Category, error) {
+	var category entity.Category
+
+	if err := r.db.First(&category, "id = ?", id).Error; err != nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &category, nil
+}
+
+func (r *CategoryRepository) FindByIds(ids []string) ([]entity.Category, error) {
+	var categories []entity.Category
+
+	if err := r.db.Where("id IN ?", ids).Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *CategoryRepository) FindBySlugs(slugs []string) ([]entity.Category, error) {
+	var categories []entity.Category
+
+	if len(slugs) == 0 {
+		return categories, nil
+	}
+
+	if err := r.db.Where("slug IN ?", slugs).Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *CategoryRepository) Update(category *entity.Category) error {
+	return r.db.Save(category).Error
+}
+
+func (r *CategoryRepository) Delete(id string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM blog_categories WHERE category_id = ?", id).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Where("id = ?", id).Delete(&entity.Category{}).Error
+	})
+}
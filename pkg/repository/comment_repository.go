@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/res"
+
+	"gorm.io/gorm"
+)
+
+// commentVisibleCondition hides comments taken down by a moderator from
+// everyone but their author, it expects the viewer id as its argument.
+const commentVisibleCondition = "(c.hidden_at IS NULL OR c.user_id = ?)"
+
+type CommentRepository struct {
+	db *gorm.DB
+}
+
+func NewCommentRepository(db *gorm.DB) *CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+func (r *CommentRepository) Create(comment *entity.Comment) error {
+	return r.db.Create(comment).Error
+}
+
+func (r *CommentRepository) FindById(id string) (*entity.Comment, error) {
+	var comment entity.Comment
+
+	if err := r.db.First(&comment, "id = ?", id).Error; err != nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &comment, nil
+}
+
+func (r *CommentRepository) FindResponseById(id string) (*res.CommentResponse, error) {
+	var comment res.CommentResponse
+
+	if row := r.db.Raw(`
+        SELECT
+            c.id,
+            c.blog_id,
+            c.parent_id,
+            c.body,
+            c.user_id,
+            c.hidden_at IS NOT NULL as hidden,
+            u.username as author,
+            c.created_at,
+            c.updated_at
+        FROM comments c
+        JOIN users u ON c.user_id = u.id
+        WHERE c.id = ? AND c.deleted_at IS NULL
+    `, id).Scan(&comment).RowsAffected; row == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &comment, nil
+}
+
+// FindAllPagination returns every comment of a blog in chronological order,
+// regardless of depth.
+func (r *CommentRepository) FindAllPagination(blogId string, page, limit int, viewerId string) ([]res.CommentResponse, int64, error) {
+	var comments []res.CommentResponse = make([]res.CommentResponse, 0)
+	var total int64
+
+	if err := r.db.Raw(`
+        SELECT COUNT(*) as total
+        FROM comments c
+        WHERE c.blog_id = ? AND c.deleted_at IS NULL AND `+commentVisibleCondition+`
+    `, blogId, viewerId).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.db.Raw(`
+        SELECT
+            c.id,
+            c.blog_id,
+            c.parent_id,
+            c.body,
+            c.user_id,
+            c.hidden_at IS NOT NULL as hidden,
+            u.username as author,
+            c.created_at,
+            c.updated_at
+        FROM comments c
+        JOIN users u ON c.user_id = u.id
+        WHERE c.blog_id = ? AND c.deleted_at IS NULL AND `+commentVisibleCondition+`
+        ORDER BY c.created_at ASC
+        LIMIT ? OFFSET ?
+    `, blogId, viewerId, limit, (page-1)*limit).Scan(&comments).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+// FindRootsPagination paginates the top level comments of a blog only, the
+// replies are loaded separately with FindDescendants.
+func (r *CommentRepository) FindRootsPagination(blogId string, page, limit int, viewerId string) ([]res.CommentResponse, int64, error) {
+	var comments []res.CommentResponse = make([]res.CommentResponse, 0)
+	var total int64
+
+	if err := r.db.Raw(`
+        SELECT COUNT(*) as total
+        FROM comments c
+        WHERE c.blog_id = ? AND c.parent_id IS NULL AND c.deleted_at IS NULL AND `+commentVisibleCondition+`
+    `, blogId, viewerId).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.db.Raw(`
+        SELECT
+            c.id,
+            c.blog_id,
+            c.parent_id,
+            c.body,
+            c.user_id,
+            c.hidden_at IS NOT NULL as hidden,
+            u.username as author,
+            c.created_at,
+            c.updated_at
+        FROM comments c
+        JOIN users u ON c.user_id = u.id
+        WHERE c.blog_id = ? AND c.parent_id IS NULL AND c.deleted_at IS NULL AND `+commentVisibleCondition+`
+        ORDER BY c.created_at ASC
+        LIMIT ? OFFSET ?
+    `, blogId, viewerId, limit, (page-1)*limit).Scan(&comments).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+// FindDescendants loads every reply below the roots, the replies below a
+// hidden comment are hidden along with it.
+func (r *CommentRepository) FindDescendants(rootIds []string, viewerId string) ([]res.CommentResponse, error) {
+	var comments []res.CommentResponse = make([]res.CommentResponse, 0)
+
+	if len(rootIds) == 0 {
+		return comments, nil
+	}
+
+	if err := r.db.Raw(`
+        WITH RECURSIVE thread AS (
+            SELECT c.id FROM comments c
+            WHERE c.parent_id IN ? AND c.deleted_at IS NULL AND `+commentVisibleCondition+`
+            UNION ALL
+            SELECT c.id FROM comments c
+            JOIN thread t ON c.parent_id = t.id
+            WHERE c.deleted_at IS NULL AND `+commentVisibleCondition+`
+        )
+        SELECT
+            c.id,
+            c.blog_id,
+            c.parent_id,
+            c.body,
+            c.user_id,
+            c.hidden_at IS NOT NULL as hidden,
+            u.username as author,
+            c.created_at,
+            c.updated_at
+        FROM comments c
+        JOIN thread t ON t.id = c.id
+        JOIN users u ON c.user_id = u.id
+        ORDER BY c.created_at ASC
+    `, rootIds, viewerId, viewerId).Scan(&comments).Error; err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+// AttachReactions fills in the reaction summary of every comment with a
+// fixed number of queries, replies must be attached before they are nested.
+func (r *CommentRepository) AttachReactions(comments []res.CommentResponse, viewerId string) error {
+	ids := make([]string, 0, len(comments))
+
+	for _, comment := range comments {
+		ids = append(ids, comment.Id)
+	}
+
+	reactions, err := loadReactionSummaries(r.db, enum.REACTION_TARGET_COMMENT, ids, viewerId)
+
+	if err != nil {
+		return err
+	}
+
+	for i := range comments {
+		comments[i].ReactionSummary = reactions[comments[i].Id]
+	}
+
+	return nil
+}
+
+func (r *CommentRepository) Update(comment *entity.Comment) error {
+	return r.db.Save(comment).Error
+}
+
+// DeleteThread soft deletes a comment together with all of its replies so no
+// orphaned replies are left behind.
+func (r *CommentRepository) DeleteThread(id string) error {
+	return r.db.Exec(`
+        WITH RECURSIVE thread AS (
+            SELECT id FROM comments WHERE id = ?
+            UNION ALL
+            SELECT c.id FROM comments c
+            JOIN thread t ON c.parent_id = t.id
+        )
+        UPDATE comments SET deleted_at = NOW()
+        WHERE id IN (SELECT id FROM thread) AND deleted_at IS NULL
+    `, id).Error
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/res"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ContributorRepository struct {
+	db *gorm.DB
+}
+
+func NewContributorRepository(db *gorm.DB) *ContributorRepository {
+	return &ContributorRepository{db: db}
+}
+
+// Save adds the contributor or changes the role they already have.
+func (r *ContributorRepository) Save(contributor *entity.BlogContributor) error {
+	return r.db.Omit("Blog", "User").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "blog_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role"}),
+	}).Create(contributor).Error
+}
+
+func (r *ContributorRepository) Delete(blogId, userId string) (bool, error) {
+	result := r.db.Where("blog_id = ? AND user_id = ?", blogId, userId).Delete(&entity.BlogContributor{})
+
+	return result.RowsAffected > 0, result.Error
+}
+
+// FindByBlog lists the owner of a blog as its author followed by the
+// co-authors and editors in the order they were added.
+func (r *ContributorRepository) FindByBlog(blogId string) ([]res.ContributorResponse, error) {
+	var contributors []res.ContributorResponse = make([]res.ContributorResponse, 0)
+
+	if err := r.db.Raw(`
+        SELECT user_id, username, role FROM (
+            SELECT b.user_id, u.username, CAST(? AS varchar) as role, b.created_at, 0 as rank
+            FROM blogs b
+            JOIN users u ON u.id = b.user_id
+            WHERE b.id = ?
+            UNION ALL
+            SELECT bc.user_id, u.username, bc.role, bc.created_at, 1 as rank
+            FROM blog_contributors bc
+            JOIN users u ON u.id = bc.user_id
+            WHERE bc.blog_id = ? AND u.deleted_at IS NULL
+        ) contributors
+        ORDER BY rank ASC, created_at ASC
+    `, enum.CONTRIBUTOR_ROLE_AUTHOR, blogId, blogId).Scan(&contributors).Error; err != nil {
+		return nil, err
+	}
+
+	return contributors, nil
+}
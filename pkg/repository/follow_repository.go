@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"learn/fiber/pkg/model/entity"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FollowCount is how many users follow a user and how many it follows,
+// soft deleted users are not counted.
+type FollowCount struct {
+	UserId         string
+	FollowerCount  int64
+	FollowingCount int64
+}
+
+type FollowRepository struct {
+	db *gorm.DB
+}
+
+func NewFollowRepository(db *gorm.DB) *FollowRepository {
+	return &FollowRepository{db: db}
+}
+
+// Create is a no-op when the follow already exists so following twice is
+// not an error.
+func (r *FollowRepository) Create(follow *entity.Follow) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(follow).Error
+}
+
+func (r *FollowRepository) Delete(followerId, followingId string) error {
+	return r.db.Where("follower_id = ? AND following_id = ?", followerId, followingId).Delete(&entity.Follow{}).Error
+}
+
+func (r *FollowRepository) FindFollowers(userId string, page, limit int) ([]entity.User, int64, error) {
+	return r.findUsers("f.follower_id", "f.following_id", userId, page, limit)
+}
+
+func (r *FollowRepository) FindFollowing(userId string, page, limit int) ([]entity.User, int64, error) {
+	return r.findUsers("f.following_id", "f.follower_id", userId, page, limit)
+}
+
+// findUsers lists the users on one side of the follows of userId, most
+// recently followed first.
+func (r *FollowRepository) findUsers(userColumn, ownerColumn, userId string, page, limit int) ([]entity.User, int64, error) {
+	var users []entity.User
+	var total int64
+
+	db := r.db.Model(&entity.User{}).
+		Joins("JOIN follows f ON "+userColumn+" = users.id").
+		Where(ownerColumn+" = ?", userId)
+
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := db.Order("f.created_at DESC").Offset((page - 1) * limit).Limit(limit).Find(&users).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+// CountByUserIds returns the follow counts of many users in one query.
+func (r *FollowRepository) CountByUserIds(userIds []string) (map[string]FollowCount, error) {
+	counts := make(map[string]FollowCount, len(userIds))
+
+	if len(userIds) == 0 {
+		return counts, nil
+	}
+
+	var rows []FollowCount
+
+	if err := r.db.Raw(`
+        SELECT
+            u.id as user_id,
+            (
+                SELECT COUNT(*) FROM follows f
+                JOIN users fu ON fu.id = f.follower_id AND fu.deleted_at IS NULL
+                WHERE f.following_id = u.id
+            ) as follower_count,
+            (
+                SELECT COUNT(*) FROM follows f
+                JOIN users fu ON fu.id = f.following_id AND fu.deleted_at IS NULL
+                WHERE f.follower_id = u.id
+            ) as following_count
+        FROM users u
+        WHERE u.id IN ?
+    `, userIds).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.UserId] = row
+	}
+
+	return counts, nil
+}
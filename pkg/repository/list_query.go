@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"fmt"
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+type listFieldType int
+
+const (
+	listFieldString listFieldType = iota
+	listFieldEnum
+	listFieldTime
+)
+
+// listOperators are the filter operators each field type accepts.
+var listOperators = map[listFieldType][]enum.EFilterOperator{
+	listFieldString: {enum.FILTER_EQ, enum.FILTER_NE, enum.FILTER_LIKE, enum.FILTER_IN},
+	listFieldEnum:   {enum.FILTER_EQ, enum.FILTER_NE, enum.FILTER_IN},
+	listFieldTime:   {enum.FILTER_EQ, enum.FILTER_NE, enum.FILTER_GT, enum.FILTER_GTE, enum.FILTER_LT, enum.FILTER_LTE},
+}
+
+// listField whitelists a field clients may filter or sort on. Column is a
+// trusted SQL expression and is written into queries as is, Values lists the
+// accepted values of enum fields.
+type listField struct {
+	Column   string
+	Type     listFieldType
+	Values   []string
+	Sortable bool
+}
+
+// listSchema maps the API name of every field to its column, anything not in
+// the schema is rejected.
+type listSchema map[string]listField
+
+var userListSchema = listSchema{
+	"id":        {Column: "id"},
+	"email":     {Column: "email", Sortable: true},
+	"username":  {Column: "username", Sortable: true},
+	"role":      {Column: "role", Type: listFieldEnum, Values: []string{string(enum.ROLE_ADMIN), string(enum.ROLE_MODERATOR), string(enum.ROLE_USER)}},
+	"createdAt": {Column: "created_at", Type: listFieldTime, Sortable: true},
+	"updatedAt": {Column: "updated_at", Type: listFieldTime, Sortable: true},
+}
+
+var blogListSchema = listSchema{
+	"id":        {Column: "b.id"},
+	"title":     {Column: "b.title", Sortable: true},
+	"userId":    {Column: "b.user_id"},
+	"owner":     {Column: "u.username", Sortable: true},
+	"language":  {Column: "b.language::text", Type: listFieldEnum, Values: []string{string(enum.LANGUAGE_ENGLISH), string(enum.LANGUAGE_INDONESIAN), string(enum.LANGUAGE_SIMPLE)}},
+	"createdAt": {Column: "b.created_at", Type: listFieldTime, Sortable: true},
+	"updatedAt": {Column: "b.updated_at", Type: listFieldTime, Sortable: true},
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// where compiles filters into conditions that must all hold, fields are
+// visited in name order so equal filters always produce the same SQL.
+func (s listSchema) where(filters model.Filters) ([]clause.Expression, error) {
+	names := make([]string, 0, len(filters))
+
+	for name := range filters {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	expressions := []clause.Expression{}
+
+	for _, name := range names {
+		field, ok := s[name]
+
+		if !ok {
+			return nil, fmt.Errorf("filter[%s]: unknown field", name)
+		}
+
+		operators := make([]string, 0, len(filters[name]))
+
+		for operator := range filters[name] {
+			operators = append(operators, string(operator))
+		}
+
+		sort.Strings(operators)
+
+		for _, operator := range operators {
+			expression, err := field.expression(name, enum.EFilterOperator(operator), filters[name][enum.EFilterOperator(operator)])
+
+			if err != nil {
+				return nil, err
+			}
+
+			expressions = append(expressions, expression)
+		}
+	}
+
+	return expressions, nil
+}
+
+// orderBy compiles a sort parameter such as "-createdAt,title", a leading
+// minus sorts that field descending. It returns nil for an empty sort.
+func (s listSchema) orderBy(value string) (*clause.OrderBy, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+
+	orderBy := &clause.OrderBy{}
+
+	for _, part := range strings.Split(value, ",") {
+		name := strings.TrimSpace(part)
+		desc := strings.HasPrefix(name, "-")
+		name = strings.TrimPrefix(name, "-")
+
+		field, ok := s[name]
+
+		if !ok {
+			return nil, fmt.Errorf("sort: unknown field %q", name)
+		}
+
+		if !field.Sortable {
+			return nil, fmt.Errorf("sort: field %q is not sortable", name)
+		}
+
+		orderBy.Columns = append(orderBy.Columns, clause.OrderByColumn{
+			Column: clause.Column{Name: field.Column, Raw: true},
+			Desc:   desc,
+		})
+	}
+
+	return orderBy, nil
+}
+
+func (f listField) expression(name string, operator enum.EFilterOperator, raw string) (clause.Expression, error) {
+	if !slices.Contains(listOperators[f.Type], operator) {
+		return nil, fmt.Errorf("filter[%s][%s]: unsupported operator", name, operator)
+	}
+
+	column := clause.Column{Name: f.Column, Raw: true}
+
+	if operator == enum.FILTER_IN {
+		values := []any{}
+
+		for _, item := range strings.Split(raw, ",") {
+			value, err := f.value(name, strings.TrimSpace(item))
+
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+		}
+
+		return clause.IN{Column: column, Values: values}, nil
+	}
+
+	if operator == enum.FILTER_LIKE {
+		return clause.Expr{SQL: "? ILIKE ?", Vars: []any{column, "%" + likeEscaper.Replace(raw) + "%"}}, nil
+	}
+
+	value, err := f.value(name, raw)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch operator {
+	case enum.FILTER_NE:
+		return clause.Neq{Column: column, Value: value}, nil
+	case enum.FILTER_GT:
+		return clause.Gt{Column: column, Value: value}, nil
+	case enum.FILTER_GTE:
+		return clause.Gte{Column: column, Value: value}, nil
+	case enum.FILTER_LT:
+		return clause.Lt{Column: column, Value: value}, nil
+	case enum.FILTER_LTE:
+		return clause.Lte{Column: column, Value: value}, nil
+	default:
+		return clause.Eq{Column: column, Value: value}, nil
+	}
+}
+
+// value converts a raw query value to the type of the field, times accept
+// RFC 3339 timestamps or plain dates.
+func (f listField) value(name, raw string) (any, error) {
+	switch f.Type {
+	case listFieldTime:
+		if value, err := time.Parse(time.RFC3339, raw); err == nil {
+			return value, nil
+		}
+
+		value, err := time.Parse(time.DateOnly, raw)
+
+		if err != nil {
+			return nil, fmt.Errorf("filter[%s]: %q is not a RFC 3339 timestamp or YYYY-MM-DD date", name, raw)
+		}
+
+		return value, nil
+	case listFieldEnum:
+		if !slices.Contains(f.Values, raw) {
+			return nil, fmt.Errorf("filter[%s]: %q must be one of %s", name, raw, strings.Join(f.Values, ", "))
+		}
+	}
+
+	return raw, nil
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/res"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReactionRepository struct {
+	db *gorm.DB
+}
+
+func NewReactionRepository(db *gorm.DB) *ReactionRepository {
+	return &ReactionRepository{db: db}
+}
+
+func (r *ReactionRepository) FindByUserAndTarget(userId string, targetType enum.EReactionTarget, targetId string) (*entity.Reaction, error) {
+	var reaction entity.Reaction
+
+	if err := r.db.First(
+		&reaction,
+		"user_id = ? AND target_type = ? AND target_id = ?",
+		userId, targetType, targetId,
+	).Error; err != nil {
+		return nil, err
+	}
+
+	return &reaction, nil
+}
+
+// Upsert relies on the unique user/target index so concurrent toggles from
+// the same user end up as a single row.
+func (r *ReactionRepository) Upsert(reaction *entity.Reaction) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "target_type"}, {Name: "target_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"type", "updated_at"}),
+	}).Create(reaction).Error
+}
+
+func (r *ReactionRepository) Delete(id string) error {
+	return r.db.Unscoped().Where("id = ?", id).Delete(&entity.Reaction{}).Error
+}
+
+func (r *ReactionRepository) FindSummary(targetType enum.EReactionTarget, targetId, viewerId string) (*res.ReactionSummary, error) {
+	summaries, err := loadReactionSummaries(r.db, targetType, []string{targetId}, viewerId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	summary := summaries[targetId]
+
+	return &summary, nil
+}
+
+// loadReactionSummaries aggregates the reactions of many targets at once so
+// list endpoints stay at a fixed number of queries. The viewer's own
+// reactions are only looked up when viewerId is not empty.
+func loadReactionSummaries(db *gorm.DB, targetType enum.EReactionTarget, targetIds []string, viewerId string) (map[string]res.ReactionSummary, error) {
+	summaries := make(map[string]res.ReactionSummary, len(targetIds))
+
+	for _, id := range targetIds {
+		summaries[id] = res.ReactionSummary{Reactions: map[enum.EReaction]int{}}
+	}
+
+	if len(targetIds) == 0 {
+		return summaries, nil
+	}
+
+	var counts []struct {
+		TargetId string
+		Type     enum.EReaction
+		Total    int
+	}
+
+	if err := db.Raw(`
+        SELECT target_id, type, COUNT(*) as total
+        FROM reactions
+        WHERE target_type = ? AND target_id IN ? AND deleted_at IS NULL
+        GROUP BY target_id, type
+    `, targetType, targetIds).Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+
+	for _, count := range counts {
+		summary := summaries[count.TargetId]
+		summary.Reactions[count.Type] = count.Total
+		summary.ReactionCount += count.Total
+		summaries[count.TargetId] = summary
+	}
+
+	if viewerId == "" {
+		return summaries, nil
+	}
+
+	var own []struct {
+		TargetId string
+		Type     enum.EReaction
+	}
+
+	if err := db.Raw(`
+        SELECT target_id, type
+        FROM reactions
+        WHERE target_type = ? AND target_id IN ? AND user_id = ? AND deleted_at IS NULL
+    `, targetType, targetIds, viewerId).Scan(&own).Error; err != nil {
+		return nil, err
+	}
+
+	for _, reaction := range own {
+		summary := summaries[reaction.TargetId]
+		reactionType := reaction.Type
+		summary.MyReaction = &reactionType
+		summary.LikedByMe = true
+		summaries[reaction.TargetId] = summary
+	}
+
+	return summaries, nil
+}
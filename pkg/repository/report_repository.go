@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/res"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// reportTargetTables are the tables holding the content each report target
+// type points at, both have a hidden_at column.
+var reportTargetTables = map[enum.EReportTarget]string{
+	enum.REPORT_TARGET_BLOG:    "blogs",
+	enum.REPORT_TARGET_COMMENT: "comments",
+}
+
+type ReportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) *ReportRepository {
+	return &ReportRepository{db: db}
+}
+
+func (r *ReportRepository) Create(report *entity.Report) error {
+	return r.db.Omit("Reporter").Create(report).Error
+}
+
+func (r *ReportRepository) ExistsByReporter(reporterId string, targetType enum.EReportTarget, targetId string) (bool, error) {
+	var total int64
+
+	if err := r.db.Model(&entity.Report{}).
+		Where("reporter_id = ? AND target_type = ? AND target_id = ?", reporterId, targetType, targetId).
+		Count(&total).Error; err != nil {
+		return false, err
+	}
+
+	return total > 0, nil
+}
+
+func (r *ReportRepository) CountPending(targetType enum.EReportTarget, targetId string) (int64, error) {
+	var total int64
+
+	err := r.db.Model(&entity.Report{}).
+		Where("target_type = ? AND target_id = ? AND status = ?", targetType, targetId, enum.REPORT_STATUS_PENDING).
+		Count(&total).Error
+
+	return total, err
+}
+
+// FindQueue lists reported content with pending reports, the most reported
+// first, optionally only blogs or only comments.
+func (r *ReportRepository) FindQueue(targetType enum.EReportTarget, page, limit int) ([]res.ModerationQueueItem, int64, error) {
+	var items []res.ModerationQueueItem = make([]res.ModerationQueueItem, 0)
+	var total int64
+
+	where := "r.status = ? AND r.deleted_at IS NULL"
+	args := []any{enum.REPORT_STATUS_PENDING}
+
+	if targetType != "" {
+		where += " AND r.target_type = ?"
+		args = append(args, targetType)
+	}
+
+	if err := r.db.Raw(`
+        SELECT COUNT(DISTINCT (r.target_type, r.target_id)) as total
+        FROM reports r
+        WHERE `+where, args...).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rows []struct {
+		res.ModerationQueueItem
+		ReasonList string
+	}
+
+	if err := r.db.Raw(`
+        SELECT
+            r.target_type,
+            r.target_id,
+            COALESCE(b.id, c.blog_id, '') as blog_id,
+            COALESCE(b.user_id, c.user_id, '') as author_id,
+            COALESCE(b.title, LEFT(c.body, 200), '') as excerpt,
+            COUNT(*) as report_count,
+            STRING_AGG(DISTINCT r.reason, ',') as reason_list,
+            COALESCE(b.hidden_at, c.hidden_at) as hidden_at,
+            MAX(r.created_at) as last_reported_at
+        FROM reports r
+        LEFT JOIN blogs b ON r.target_type = 'blog' AND b.id = r.target_id
+        LEFT JOIN comments c ON r.target_type = 'comment' AND c.id = r.target_id
+        WHERE `+where+`
+        GROUP BY r.target_type, r.target_id, b.id, c.id
+        ORDER BY report_count DESC, last_reported_at DESC
+        LIMIT ? OFFSET ?
+    `, append(args, limit, (page-1)*limit)...).Scan(&rows).Error; err != nil {
+		return nil, 0, err
+	}
+
+	for _, row := range rows {
+		row.Reasons = strings.Split(row.ReasonList, ",")
+		items = append(items, row.ModerationQueueItem)
+	}
+
+	return items, total, nil
+}
+
+// SetHidden hides or restores the reported content without resolving its
+// reports, so auto-hidden content stays in the queue for review.
+func (r *ReportRepository) SetHidden(targetType enum.EReportTarget, targetId string, hidden bool) error {
+	return setHidden(r.db, targetType, targetId, hidden)
+}
+
+// Resolve sets the visibility of the content and closes its pending reports
+// with status in one transaction.
+func (r *ReportRepository) Resolve(targetType enum.EReportTarget, targetId string, hidden *bool, status enum.EReportStatus, moderatorId string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if hidden != nil {
+			if err := setHidden(tx, targetType, targetId, *hidden); err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&entity.Report{}).
+			Where("target_type = ? AND target_id = ? AND status = ?", targetType, targetId, enum.REPORT_STATUS_PENDING).
+			Updates(map[string]any{
+				"status":      status,
+				"resolved_by": moderatorId,
+				"resolved_at": time.Now(),
+			}).Error
+	})
+}
+
+// setHidden also bumps updated_at of a blog, unhiding one lowers
+// MAX(hidden_at) and would not invalidate the caches keyed on
+// BlogRepository.LastChangedAt otherwise.
+func setHidden(db *gorm.DB, targetType enum.EReportTarget, targetId string, hidden bool) error {
+	now := time.Now()
+	values := map[string]any{"hidden_at": nil}
+
+	if hidden {
+		values["hidden_at"] = now
+	}
+
+	if targetType == enum.REPORT_TARGET_BLOG {
+		values["updated_at"] = now
+	}
+
+	return db.Table(reportTargetTables[targetType]).
+		Where("id = ?", targetId).
+		Updates(values).Error
+}
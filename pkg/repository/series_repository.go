@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/res"
+
+	"gorm.io/gorm"
+)
+
+// seriesSelectQuery selects res.SeriesResponse rows, post counts leave out
+// deleted and hidden blogs. Callers append their own WHERE and ORDER BY.
+const seriesSelectQuery = `
+        SELECT
+            s.id,
+            s.title,
+            s.description,
+            s.user_id,
+            u.username as owner,
+            (
+                SELECT COUNT(*) FROM series_blogs sb
+                JOIN blogs b ON b.id = sb.blog_id
+                WHERE sb.series_id = s.id AND b.deleted_at IS NULL AND b.hidden_at IS NULL
+            ) as post_count,
+            s.created_at,
+            s.updated_at
+        FROM series s
+        JOIN users u ON s.user_id = u.id
+`
+
+type SeriesRepository struct {
+	db *gorm.DB
+}
+
+func NewSeriesRepository(db *gorm.DB) *SeriesRepository {
+	return &SeriesRepository{db: db}
+}
+
+// Create stores the series with its blogs in the given order.
+func (r *SeriesRepository) Create(series *entity.Series, blogIds []string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("User").Create(series).Error; err != nil {
+			return err
+		}
+
+		return replaceSeriesBlogs(tx, series.Id, blogIds)
+	})
+}
+
+// Update saves the series and replaces its blogs, blogIds nil keeps them.
+func (r *SeriesRepository) Update(series *entity.Series, blogIds []string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("User").Save(series).Error; err != nil {
+			return err
+		}
+
+		if blogIds == nil {
+			return nil
+		}
+
+		return replaceSeriesBlogs(tx, series.Id, blogIds)
+	})
+}
+
+// Delete soft deletes the series and releases its blogs so they can join
+// another series.
+func (r *SeriesRepository) Delete(id string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("series_id = ?", id).Delete(&entity.SeriesBlog{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("id = ?", id).Delete(&entity.Series{}).Error
+	})
+}
+
+func (r *SeriesRepository) FindById(id string) (*entity.Series, error) {
+	var series entity.Series
+
+	if err := r.db.First(&series, "id = ?", id).Error; err != nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &series, nil
+}
+
+func (r *SeriesRepository) FindResponseById(id string) (*res.SeriesResponse, error) {
+	var series res.SeriesResponse
+
+	if row := r.db.Raw(seriesSelectQuery+`
+        WHERE s.id = ? AND s.deleted_at IS NULL
+    `, id).Scan(&series).RowsAffected; row == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &series, nil
+}
+
+func (r *SeriesRepository) FindByUser(userId string) ([]res.SeriesResponse, error) {
+	var series []res.SeriesResponse = make([]res.SeriesResponse, 0)
+
+	if err := r.db.Raw(seriesSelectQuery+`
+        WHERE s.user_id = ? AND s.deleted_at IS NULL
+        ORDER BY s.created_at DESC
+    `, userId).Scan(&series).Error; err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
+
+// FindTaken returns the blogs among blogIds that are already part of another
+// series than excludeId.
+func (r *SeriesRepository) FindTaken(blogIds []string, excludeId string) ([]entity.SeriesBlog, error) {
+	var taken []entity.SeriesBlog
+
+	if len(blogIds) == 0 {
+		return taken, nil
+	}
+
+	if err := r.db.Where("blog_id IN ? AND series_id <> ?", blogIds, excludeId).Find(&taken).Error; err != nil {
+		return nil, err
+	}
+
+	return taken, nil
+}
+
+func replaceSeriesBlogs(tx *gorm.DB, seriesId string, blogIds []string) error {
+	if err := tx.Where("series_id = ?", seriesId).Delete(&entity.SeriesBlog{}).Error; err != nil {
+		return err
+	}
+
+	if len(blogIds) == 0 {
+		return nil
+	}
+
+	rows := make([]entity.SeriesBlog, 0, len(blogIds))
+
+	for position, blogId := range blogIds {
+		rows = append(rows, entity.SeriesBlog{SeriesId: seriesId, BlogId: blogId, Position: position + 1})
+	}
+
+	return tx.Omit("Series", "Blog").Create(&rows).Error
+}
+
+// loadSeriesNavigation finds the series of a blog and its neighbours among
+// the posts the viewer can see, nil when the blog is not part of a series.
+func loadSeriesNavigation(db *gorm.DB, blogId, viewerId string) (*res.BlogSeriesResponse, error) {
+	var posts []struct {
+		SeriesId    string
+		SeriesTitle string
+		BlogId      string
+		Title       string
+	}
+
+	if err := db.Raw(`
+        SELECT s.id as series_id, s.title as series_title, b.id as blog_id, b.title
+        FROM series_blogs sb
+        JOIN series s ON s.id = sb.series_id AND s.deleted_at IS NULL
+        JOIN blogs b ON b.id = sb.blog_id AND `+blogVisibleCondition+`
+        WHERE sb.series_id = (SELECT series_id FROM series_blogs WHERE blog_id = ?)
+        ORDER BY sb.position ASC
+    `, viewerId, blogId).Scan(&posts).Error; err != nil {
+		return nil, err
+	}
+
+	for i, post := range posts {
+		if post.BlogId != blogId {
+			continue
+		}
+
+		series := &res.BlogSeriesResponse{
+			Id:       post.SeriesId,
+			Title:    post.SeriesTitle,
+			Position: i + 1,
+			Total:    len(posts),
+		}
+
+		if i > 0 {
+			series.Previous = &res.SeriesPostLink{Id: posts[i-1].BlogId, Title: posts[i-1].Title}
+		}
+
+		if i < len(posts)-1 {
+			series.Next = &res.SeriesPostLink{Id: posts[i+1].BlogId, Title: posts[i+1].Title}
+		}
+
+		return series, nil
+	}
+
+	return nil, nil
+}
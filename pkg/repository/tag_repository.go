@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/res"
+
+	"gorm.io/gorm"
+)
+
+type TagRepository struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) *TagRepository {
+	return &TagRepository{db: db}
+}
+
+func (r *TagRepository) Create(tag *entity.Tag) error {
+	return r.db.Create(tag).Error
+}
+
+func (r *TagRepository) FindOrCreate(name, slug string) (*entity.Tag, error) {
+	var tag entity.Tag
+
+	if err := r.db.Where(entity.Tag{Slug: slug}).Attrs(entity.Tag{Name: name}).FirstOrCreate(&tag).Error; err != nil {
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+func (r *TagRepository) FindBySlug(slug string) (*entity.Tag, error) {
+	var tag entity.Tag
+
+	if err := r.db.First(&tag, "slug = ?", slug).Error; err != nil {
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+// FindAllWithCount counts the blogs every reader can see, like the public
+// blog lists it leaves out trashed blogs and blogs hidden by a moderator.
+func (r *TagRepository) FindAllWithCount() ([]res.TagWithCountResponse, error) {
+	var tags []res.TagWithCountResponse = make([]res.TagWithCountResponse, 0)
+
+	if err := r.db.Raw(`
+        SELECT
+            t.id,
+            t.name,
+            t.slug,
+            COUNT(b.id) as post_count
+        FROM tags t
+        LEFT JOIN blog_tags bt ON bt.tag_id = t.id
+        LEFT JOIN blogs b ON b.id = bt.blog_id AND b.deleted_at IS NULL AND b.hidden_at IS NULL
+        WHERE t.deleted_at IS NULL
+        GROUP BY t.id, t.name, t.slug
+        ORDER BY t.name ASC
+    `).Scan(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (r *TagRepository) FindById(id string) (*entity.Tag, error) {
+	var tag entity.Tag
+
+	if err := r.db.First(&tag, "id = ?", id).Error; err != nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &tag, nil
+}
+
+func (r *TagRepository) Update(tag *entity.Tag) error {
+	return r.db.Save(tag).Error
+}
+
+func (r *TagRepository) Delete(id string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM blog_tags WHERE tag_id = ?", id).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().Where("id = ?", id).Delete(&entity.Tag{}).Error
+	})
+}
@@ -0,0 +1,280 @@
+package repository
+
+import (
+	"learn/fiber/pkg/model/res"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const trashUserSelectQuery = `
+        SELECT
+            u.id,
+            u.email,
+            u.username,
+            u.role,
+            (
+                SELECT COUNT(*) FROM blogs b
+                WHERE b.user_id = u.id AND b.deleted_at = u.deleted_at
+            ) as blog_count,
+            u.deleted_at
+        FROM users u
+        WHERE u.deleted_at IS NOT NULL
+`
+
+const trashBlogSelectQuery = `
+        SELECT
+            b.id,
+            b.title,
+            b.user_id,
+            u.username as owner,
+            u.deleted_at IS NOT NULL as owner_deleted,
+            b.deleted_at
+        FROM blogs b
+        JOIN users u ON b.user_id = u.id
+        WHERE b.deleted_at IS NOT NULL
+`
+
+// TrashRepository works on soft deleted users and blogs, every query here
+// reads past the deleted_at scope of GORM on purpose.
+type TrashRepository struct {
+	db *gorm.DB
+}
+
+func NewTrashRepository(db *gorm.DB) *TrashRepository {
+	return &TrashRepository{db: db}
+}
+
+func (r *TrashRepository) FindUsers(page, limit int) ([]res.TrashUserResponse, int64, error) {
+	var users []res.TrashUserResponse = make([]res.TrashUserResponse, 0)
+	var total int64
+
+	if err := r.db.Raw(`SELECT COUNT(*) FROM users WHERE deleted_at IS NOT NULL`).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.db.Raw(trashUserSelectQuery+`
+        ORDER BY u.deleted_at DESC
+        LIMIT ? OFFSET ?
+    `, limit, (page-1)*limit).Scan(&users).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return users, total, nil
+}
+
+func (r *TrashRepository) FindUser(id string) (*res.TrashUserResponse, error) {
+	var user res.TrashUserResponse
+
+	if row := r.db.Raw(trashUserSelectQuery+`
+        AND u.id = ?
+    `, id).Scan(&user).RowsAffected; row == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &user, nil
+}
+
+func (r *TrashRepository) FindBlogs(page, limit int) ([]res.TrashBlogResponse, int64, error) {
+	var blogs []res.TrashBlogResponse = make([]res.TrashBlogResponse, 0)
+	var total int64
+
+	if err := r.db.Raw(`SELECT COUNT(*) FROM blogs WHERE deleted_at IS NOT NULL`).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := r.db.Raw(trashBlogSelectQuery+`
+        ORDER BY b.deleted_at DESC
+        LIMIT ? OFFSET ?
+    `, limit, (page-1)*limit).Scan(&blogs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return blogs, total, nil
+}
+
+func (r *TrashRepository) FindBlog(id string) (*res.TrashBlogResponse, error) {
+	var blog res.TrashBlogResponse
+
+	if row := r.db.Raw(trashBlogSelectQuery+`
+        AND b.id = ?
+    `, id).Scan(&blog).RowsAffected; row == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &blog, nil
+}
+
+// RestoreUser brings back a deleted user with the blogs that were deleted
+// together with it, blogs the user deleted before stay in the trash.
+func (r *TrashRepository) RestoreUser(id string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(`
+        UPDATE blogs SET deleted_at = NULL, updated_at = NOW()
+        WHERE user_id = ? AND deleted_at = (SELECT deleted_at FROM users WHERE id = ?)
+    `, id, id).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec(`UPDATE users SET deleted_at = NULL WHERE id = ?`, id).Error
+	})
+}
+
+func (r *TrashRepository) RestoreBlog(id string) error {
+	return r.db.Exec(`UPDATE blogs SET deleted_at = NULL, updated_at = NOW() WHERE id = ?`, id).Error
+}
+
+// PurgeUser removes a deleted user for good with its blogs, comments,
+// reactions, follows, bookmarks and series. Replies to its comments go with
+// them, revisions it made of other blogs are credited to their owner.
+func (r *TrashRepository) PurgeUser(id string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return purgeUser(tx, id)
+	})
+}
+
+func (r *TrashRepository) PurgeBlog(id string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return purgeBlogs(tx, []string{id})
+	})
+}
+
+// PurgeExpired removes users and blogs deleted before the cutoff and counts
+// them. Every user is purged in its own transaction, so those purged before a
+// failure stay purged.
+func (r *TrashRepository) PurgeExpired(before time.Time) (int, int, error) {
+	var userIds []string
+
+	if err := r.db.Raw(`SELECT id FROM users WHERE deleted_at < ?`, before).Scan(&userIds).Error; err != nil {
+		return 0, 0, err
+	}
+
+	for i, userId := range userIds {
+		if err := r.PurgeUser(userId); err != nil {
+			return i, 0, err
+		}
+	}
+
+	var blogIds []string
+
+	if err := r.db.Raw(`SELECT id FROM blogs WHERE deleted_at < ?`, before).Scan(&blogIds).Error; err != nil {
+		return len(userIds), 0, err
+	}
+
+	if err := r.db.Transaction(func(tx *gorm.DB) error {
+		return purgeBlogs(tx, blogIds)
+	}); err != nil {
+		return len(userIds), 0, err
+	}
+
+	return len(userIds), len(blogIds), nil
+}
+
+func purgeUser(tx *gorm.DB, id string) error {
+	var blogIds []string
+
+	if err := tx.Raw(`SELECT id FROM blogs WHERE user_id = ?`, id).Scan(&blogIds).Error; err != nil {
+		return err
+	}
+
+	if err := purgeBlogs(tx, blogIds); err != nil {
+		return err
+	}
+
+	var commentIds []string
+
+	if err := tx.Raw(`
+        WITH RECURSIVE doomed AS (
+            SELECT id FROM comments WHERE user_id = ?
+            UNION
+            SELECT c.id FROM comments c JOIN doomed d ON c.parent_id = d.id
+        )
+        SELECT id FROM doomed
+    `, id).Scan(&commentIds).Error; err != nil {
+		return err
+	}
+
+	if err := purgeComments(tx, commentIds); err != nil {
+		return err
+	}
+
+	for _, query := range []string{
+		`DELETE FROM reactions WHERE user_id = ?`,
+		`DELETE FROM reports WHERE reporter_id = ?`,
+		`UPDATE reports SET resolved_by = NULL WHERE resolved_by = ?`,
+		`DELETE FROM follows WHERE follower_id = ?`,
+		`DELETE FROM follows WHERE following_id = ?`,
+		`DELETE FROM bookmarks WHERE user_id = ?`,
+		`DELETE FROM bookmark_folders WHERE user_id = ?`,
+		`DELETE FROM series_blogs WHERE series_id IN (SELECT id FROM series WHERE user_id = ?)`,
+		`DELETE FROM series WHERE user_id = ?`,
+		`DELETE FROM blog_contributors WHERE user_id = ?`,
+		`UPDATE blog_revisions SET editor_id = (SELECT b.user_id FROM blogs b WHERE b.id = blog_revisions.blog_id) WHERE editor_id = ?`,
+		`DELETE FROM users WHERE id = ?`,
+	} {
+		if err := tx.Exec(query, id).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// purgeBlogs hard deletes blogs with everything attached to them.
+func purgeBlogs(tx *gorm.DB, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var commentIds []string
+
+	if err := tx.Raw(`SELECT id FROM comments WHERE blog_id IN ?`, ids).Scan(&commentIds).Error; err != nil {
+		return err
+	}
+
+	if err := purgeComments(tx, commentIds); err != nil {
+		return err
+	}
+
+	for _, query := range []string{
+		`DELETE FROM reactions WHERE target_type = 'blog' AND target_id IN ?`,
+		`DELETE FROM reports WHERE target_type = 'blog' AND target_id IN ?`,
+		`DELETE FROM blog_tags WHERE blog_id IN ?`,
+		`DELETE FROM blog_categories WHERE blog_id IN ?`,
+		`DELETE FROM blog_revisions WHERE blog_id IN ?`,
+		`DELETE FROM blog_translations WHERE blog_id IN ?`,
+		`DELETE FROM blog_contributors WHERE blog_id IN ?`,
+		`DELETE FROM series_blogs WHERE blog_id IN ?`,
+		`DELETE FROM blog_view_stats WHERE blog_id IN ?`,
+		`DELETE FROM blog_view_referrers WHERE blog_id IN ?`,
+		`DELETE FROM blog_view_visitors WHERE blog_id IN ?`,
+		`DELETE FROM bookmarks WHERE blog_id IN ?`,
+		`DELETE FROM blogs WHERE id IN ?`,
+	} {
+		if err := tx.Exec(query, ids).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// purgeComments hard deletes comments with their reactions and reports, ids
+// must hold every reply of the comments too.
+func purgeComments(tx *gorm.DB, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	for _, query := range []string{
+		`DELETE FROM reactions WHERE target_type = 'comment' AND target_id IN ?`,
+		`DELETE FROM reports WHERE target_type = 'comment' AND target_id IN ?`,
+		`DELETE FROM comments WHERE id IN ?`,
+	} {
+		if err := tx.Exec(query, ids).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,7 +1,10 @@
 package repository
 
 import (
+	"learn/fiber/pkg/model"
 	"learn/fiber/pkg/model/entity"
+	"learn/fiber/utils"
+	"slices"
 
 	"gorm.io/gorm"
 )
@@ -28,7 +31,7 @@ func (r *UserRepository) FindAll() ([]entity.User, error) {
 	return users, nil
 }
 
-func (r *UserRepository) FindAllPaginated(page, limit int, search string) ([]entity.User, int64, error) {
+func (r *UserRepository) FindAllPaginated(page, limit int, search string, filters model.Filters, sort string) ([]entity.User, int64, error) {
 	var users []entity.User
 	var total int64
 
@@ -40,6 +43,22 @@ func (r *UserRepository) FindAllPaginated(page, limit int, search string) ([]ent
 		db = db.Where("username LIKE ? OR email LIKE ?", searchPattern, searchPattern)
 	}
 
+	expressions, err := userListSchema.where(filters)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, expression := range expressions {
+		db = db.Where(expression)
+	}
+
+	orderBy, err := userListSchema.orderBy(sort)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
 	if err := db.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
@@ -54,6 +73,10 @@ func (r *UserRepository) FindAllPaginated(page, limit int, search string) ([]ent
 
 	offset := (page - 1) * limit
 
+	if orderBy != nil {
+		db = db.Clauses(*orderBy)
+	}
+
 	if err := db.Offset(offset).Limit(limit).Find(&users).Error; err != nil {
 		return nil, 0, err
 	}
@@ -61,6 +84,47 @@ func (r *UserRepository) FindAllPaginated(page, limit int, search string) ([]ent
 	return users, total, nil
 }
 
+// FindAllCursor is the keyset counterpart of FindAllPaginated, see
+// BlogRepository.FindAllCursor for how the cursor is applied.
+func (r *UserRepository) FindAllCursor(limit int, search string, cursor *utils.Cursor) ([]entity.User, bool, error) {
+	var users []entity.User
+
+	db := r.db.Model(&entity.User{})
+
+	if search != "" {
+		searchPattern := "%" + search + "%"
+
+		db = db.Where("username LIKE ? OR email LIKE ?", searchPattern, searchPattern)
+	}
+
+	order := "created_at DESC, id DESC"
+
+	if cursor != nil {
+		if cursor.Backward {
+			db = db.Where("(created_at, id) > (?, ?)", cursor.CreatedAt, cursor.Id)
+			order = "created_at ASC, id ASC"
+		} else {
+			db = db.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.Id)
+		}
+	}
+
+	if err := db.Order(order).Limit(limit + 1).Find(&users).Error; err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(users) > limit
+
+	if hasMore {
+		users = users[:limit]
+	}
+
+	if cursor != nil && cursor.Backward {
+		slices.Reverse(users)
+	}
+
+	return users, hasMore, nil
+}
+
 func (r *UserRepository) FindById(id string) (*entity.User, error) {
 	var user entity.User
 	if err := r.db.First(&user, "id = ?", id).Error; err != nil {
@@ -83,6 +147,23 @@ func (r *UserRepository) Update(user *entity.User) error {
 	return r.db.Save(user).Error
 }
 
+// Delete soft deletes the user and its live blogs with the same timestamp,
+// so restoring the user brings back exactly those blogs.
 func (r *UserRepository) Delete(id string) error {
-	return r.db.Where("id = ?", id).Delete(&entity.User{}).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", id).Delete(&entity.User{})
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Exec(`
+        UPDATE blogs SET deleted_at = (SELECT deleted_at FROM users WHERE id = ?)
+        WHERE user_id = ? AND deleted_at IS NULL
+    `, id, id).Error
+	})
 }
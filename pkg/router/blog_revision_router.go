@@ -0,0 +1,24 @@
+package router
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/handler"
+	"learn/fiber/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func BlogRevisionRouter(app fiber.Router, blogRevisionHandler *handler.BlogRevisionHandler) {
+
+	revision := app.Group(
+		"/blog/:id/revisions",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+	)
+
+	revision.Get("/", blogRevisionHandler.FindAllPaginateHandler)
+	revision.Get("/diff", blogRevisionHandler.DiffHandler)
+	revision.Get("/:rev", blogRevisionHandler.FindByNumberHandler)
+	revision.Post("/:rev/restore", blogRevisionHandler.RestoreHandler)
+
+}
@@ -1,6 +1,7 @@
 package router
 
 import (
+	"learn/fiber/pkg/enum"
 	"learn/fiber/pkg/handler"
 	"learn/fiber/pkg/middleware"
 
@@ -12,7 +13,29 @@ func BlogRouter(app fiber.Router, blogHandler *handler.BlogHandler) {
 	blog := app.Group("/blog")
 
 	blog.Post("/", middleware.JWTMidleware, blogHandler.CreateBlogHandler)
-	blog.Get("/paginate", blogHandler.FindAllPaginateHandler)
-	blog.Get("/:id", blogHandler.FindBlogByIdHandler)
+	blog.Get("/paginate", middleware.OptionalJWT, blogHandler.FindAllPaginateHandler)
+	blog.Get("/cursor", middleware.OptionalJWT, blogHandler.FindAllCursorHandler)
+	blog.Get("/feed", middleware.JWTMidleware, blogHandler.FindFollowingFeedHandler)
+	blog.Get("/search", middleware.OptionalJWT, blogHandler.SearchBlogHandler)
+	blog.Get("/export", middleware.JWTMidleware, blogHandler.ExportBlogsHandler)
+	blog.Post("/import", middleware.JWTMidleware, blogHandler.ImportBlogsHandler)
+	blog.Get(
+		"/:id",
+		middleware.OptionalJWT,
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		blogHandler.FindBlogByIdHandler,
+	)
+	blog.Put(
+		"/:id",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		blogHandler.UpdateBlogHandler,
+	)
+	blog.Get(
+		"/:id/related",
+		middleware.OptionalJWT,
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		blogHandler.FindRelatedBlogsHandler,
+	)
 
 }
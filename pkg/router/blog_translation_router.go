@@ -0,0 +1,33 @@
+package router
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/handler"
+	"learn/fiber/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func BlogTranslationRouter(app fiber.Router, blogTranslationHandler *handler.BlogTranslationHandler) {
+
+	blog := app.Group("/blog/:id")
+
+	blog.Get(
+		"/translations",
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		blogTranslationHandler.FindTranslationsHandler,
+	)
+	blog.Put(
+		"/translations/:locale",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		blogTranslationHandler.SaveTranslationHandler,
+	)
+	blog.Delete(
+		"/translations/:locale",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		blogTranslationHandler.DeleteTranslationHandler,
+	)
+
+}
@@ -0,0 +1,22 @@
+package router
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/handler"
+	"learn/fiber/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func BlogViewRouter(app fiber.Router, blogViewHandler *handler.BlogViewHandler) {
+
+	blog := app.Group("/blog/:id")
+
+	blog.Get(
+		"/stats",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		blogViewHandler.FindStatsHandler,
+	)
+
+}
@@ -0,0 +1,46 @@
+package router
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/handler"
+	"learn/fiber/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func BookmarkRouter(app fiber.Router, bookmarkHandler *handler.BookmarkHandler) {
+
+	blog := app.Group("/blog/:id")
+
+	blog.Post(
+		"/bookmark",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		bookmarkHandler.ToggleBookmarkHandler,
+	)
+	blog.Delete(
+		"/bookmark",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		bookmarkHandler.RemoveBookmarkHandler,
+	)
+
+	me := app.Group("/user/me")
+
+	me.Get("/bookmarks", middleware.JWTMidleware, bookmarkHandler.FindBookmarksHandler)
+	me.Get("/bookmark-folders", middleware.JWTMidleware, bookmarkHandler.FindFoldersHandler)
+	me.Post("/bookmark-folders", middleware.JWTMidleware, bookmarkHandler.CreateFolderHandler)
+	me.Put(
+		"/bookmark-folders/:folderId",
+		middleware.JWTMidleware,
+		middleware.ValidateId("folderId", enum.ID_PREFIX_FOLDER),
+		bookmarkHandler.UpdateFolderHandler,
+	)
+	me.Delete(
+		"/bookmark-folders/:folderId",
+		middleware.JWTMidleware,
+		middleware.ValidateId("folderId", enum.ID_PREFIX_FOLDER),
+		bookmarkHandler.DeleteFolderHandler,
+	)
+
+}
@@ -0,0 +1,37 @@
+package router
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/handler"
+	"learn/fiber/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func CategoryRouter(app fiber.Router, categoryHandler *handler.CategoryHandler) {
+
+	category := app.Group("/category")
+
+	category.Get("/", categoryHandler.FindAllCategoryHandler)
+	category.Post(
+		"/",
+		middleware.JWTMidleware,
+		middleware.RoleMiddleware(enum.ROLE_ADMIN),
+		categoryHandler.CreateCategoryHandler,
+	)
+	category.Put(
+		"/:id",
+		middleware.JWTMidleware,
+		middleware.RoleMiddleware(enum.ROLE_ADMIN),
+		middleware.ValidateId("id", enum.ID_PREFIX_CATEGORY),
+		categoryHandler.UpdateCategoryByIdHandler,
+	)
+	category.Delete(
+		"/:id",
+		middleware.JWTMidleware,
+		middleware.RoleMiddleware(enum.ROLE_ADMIN),
+		middleware.ValidateId("id", enum.ID_PREFIX_CATEGORY),
+		categoryHandler.DeleteCategoryByIdHandler,
+	)
+
+}
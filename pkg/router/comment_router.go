@@ -0,0 +1,30 @@
+package router
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/handler"
+	"learn/fiber/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func CommentRouter(app fiber.Router, commentHandler *handler.CommentHandler) {
+
+	comment := app.Group("/blog/:id/comments", middleware.ValidateId("id", enum.ID_PREFIX_BLOG))
+
+	comment.Post("/", middleware.JWTMidleware, commentHandler.CreateCommentHandler)
+	comment.Get("/", middleware.OptionalJWT, commentHandler.FindAllPaginateHandler)
+	comment.Put(
+		"/:commentId",
+		middleware.JWTMidleware,
+		middleware.ValidateId("commentId", enum.ID_PREFIX_COMMENT),
+		commentHandler.UpdateCommentHandler,
+	)
+	comment.Delete(
+		"/:commentId",
+		middleware.JWTMidleware,
+		middleware.ValidateId("commentId", enum.ID_PREFIX_COMMENT),
+		commentHandler.DeleteCommentHandler,
+	)
+
+}
@@ -0,0 +1,42 @@
+package router
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/handler"
+	"learn/fiber/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func ContributorRouter(app fiber.Router, contributorHandler *handler.ContributorHandler) {
+
+	blog := app.Group("/blog/:id")
+
+	blog.Get(
+		"/contributors",
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		contributorHandler.FindContributorsHandler,
+	)
+	blog.Put(
+		"/contributors/:userId",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		middleware.ValidateId("userId", enum.ID_PREFIX_USER),
+		contributorHandler.SetContributorHandler,
+	)
+	blog.Delete(
+		"/contributors/:userId",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		middleware.ValidateId("userId", enum.ID_PREFIX_USER),
+		contributorHandler.RemoveContributorHandler,
+	)
+
+	app.Get(
+		"/user/:id/blogs",
+		middleware.OptionalJWT,
+		middleware.ValidateId("id", enum.ID_PREFIX_USER),
+		contributorHandler.FindContributionsHandler,
+	)
+
+}
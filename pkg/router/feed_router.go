@@ -0,0 +1,17 @@
+package router
+
+import (
+	"learn/fiber/pkg/handler"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func FeedRouter(app fiber.Router, feedHandler *handler.FeedHandler) {
+
+	feed := app.Group("/blog")
+
+	feed.Get("/feed.rss", feedHandler.RssHandler)
+	feed.Get("/feed.atom", feedHandler.AtomHandler)
+	feed.Get("/feed.json", feedHandler.JsonHandler)
+
+}
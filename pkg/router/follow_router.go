@@ -0,0 +1,40 @@
+package router
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/handler"
+	"learn/fiber/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func FollowRouter(app fiber.Router, followHandler *handler.FollowHandler) {
+
+	user := app.Group("/user/:id")
+
+	user.Post(
+		"/follow",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_USER),
+		followHandler.FollowHandler,
+	)
+	user.Delete(
+		"/follow",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_USER),
+		followHandler.UnfollowHandler,
+	)
+	user.Get(
+		"/followers",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_USER),
+		followHandler.FindFollowersHandler,
+	)
+	user.Get(
+		"/following",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_USER),
+		followHandler.FindFollowingHandler,
+	)
+
+}
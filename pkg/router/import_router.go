@@ -0,0 +1,22 @@
+package router
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/handler"
+	"learn/fiber/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func ImportRouter(app fiber.Router, importHandler *handler.ImportHandler) {
+
+	admin := app.Group("/admin")
+
+	admin.Post(
+		"/import/wordpress",
+		middleware.JWTMidleware,
+		middleware.RoleMiddleware(enum.ROLE_ADMIN),
+		importHandler.WordPressImportHandler,
+	)
+
+}
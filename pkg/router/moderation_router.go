@@ -0,0 +1,54 @@
+package router
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/handler"
+	"learn/fiber/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func ModerationRouter(app fiber.Router, moderationHandler *handler.ModerationHandler) {
+
+	app.Post(
+		"/blog/:id/report",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		moderationHandler.ReportBlogHandler,
+	)
+	app.Post(
+		"/blog/:id/comments/:commentId/report",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		middleware.ValidateId("commentId", enum.ID_PREFIX_COMMENT),
+		moderationHandler.ReportCommentHandler,
+	)
+
+	moderation := app.Group("/moderation")
+
+	moderation.Get(
+		"/queue",
+		middleware.JWTMidleware,
+		middleware.RoleMiddleware(enum.ROLE_MODERATOR, enum.ROLE_ADMIN),
+		moderationHandler.FindQueueHandler,
+	)
+	moderation.Post(
+		"/:targetType/:targetId/hide",
+		middleware.JWTMidleware,
+		middleware.RoleMiddleware(enum.ROLE_MODERATOR, enum.ROLE_ADMIN),
+		moderationHandler.HideHandler,
+	)
+	moderation.Post(
+		"/:targetType/:targetId/restore",
+		middleware.JWTMidleware,
+		middleware.RoleMiddleware(enum.ROLE_MODERATOR, enum.ROLE_ADMIN),
+		moderationHandler.RestoreHandler,
+	)
+	moderation.Post(
+		"/:targetType/:targetId/dismiss",
+		middleware.JWTMidleware,
+		middleware.RoleMiddleware(enum.ROLE_MODERATOR, enum.ROLE_ADMIN),
+		moderationHandler.DismissHandler,
+	)
+
+}
@@ -0,0 +1,29 @@
+package router
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/handler"
+	"learn/fiber/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func ReactionRouter(app fiber.Router, reactionHandler *handler.ReactionHandler) {
+
+	blog := app.Group("/blog/:id")
+
+	blog.Post(
+		"/reactions",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		reactionHandler.ToggleBlogReactionHandler,
+	)
+	blog.Post(
+		"/comments/:commentId/reactions",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		middleware.ValidateId("commentId", enum.ID_PREFIX_COMMENT),
+		reactionHandler.ToggleCommentReactionHandler,
+	)
+
+}
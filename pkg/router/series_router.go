@@ -0,0 +1,37 @@
+package router
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/handler"
+	"learn/fiber/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SeriesRouter(app fiber.Router, seriesHandler *handler.SeriesHandler) {
+
+	series := app.Group("/series")
+
+	series.Post("/", middleware.JWTMidleware, seriesHandler.CreateSeriesHandler)
+	series.Get(
+		"/:id",
+		middleware.OptionalJWT,
+		middleware.ValidateId("id", enum.ID_PREFIX_SERIES),
+		seriesHandler.FindSeriesByIdHandler,
+	)
+	series.Put(
+		"/:id",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_SERIES),
+		seriesHandler.UpdateSeriesHandler,
+	)
+	series.Delete(
+		"/:id",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_SERIES),
+		seriesHandler.DeleteSeriesHandler,
+	)
+
+	app.Get("/user/me/series", middleware.JWTMidleware, seriesHandler.FindMySeriesHandler)
+
+}
@@ -0,0 +1,37 @@
+package router
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/handler"
+	"learn/fiber/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TagRouter(app fiber.Router, tagHandler *handler.TagHandler) {
+
+	tag := app.Group("/tag")
+
+	tag.Get("/", tagHandler.FindAllTagHandler)
+	tag.Post(
+		"/",
+		middleware.JWTMidleware,
+		middleware.RoleMiddleware(enum.ROLE_ADMIN),
+		tagHandler.CreateTagHandler,
+	)
+	tag.Put(
+		"/:id",
+		middleware.JWTMidleware,
+		middleware.RoleMiddleware(enum.ROLE_ADMIN),
+		middleware.ValidateId("id", enum.ID_PREFIX_TAG),
+		tagHandler.UpdateTagByIdHandler,
+	)
+	tag.Delete(
+		"/:id",
+		middleware.JWTMidleware,
+		middleware.RoleMiddleware(enum.ROLE_ADMIN),
+		middleware.ValidateId("id", enum.ID_PREFIX_TAG),
+		tagHandler.DeleteTagByIdHandler,
+	)
+
+}
@@ -0,0 +1,38 @@
+package router
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/handler"
+	"learn/fiber/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TrashRouter(app fiber.Router, trashHandler *handler.TrashHandler) {
+
+	trash := app.Group("/admin/trash", middleware.JWTMidleware, middleware.RoleMiddleware(enum.ROLE_ADMIN))
+
+	trash.Get("/users", trashHandler.FindUsersHandler)
+	trash.Post(
+		"/users/:id/restore",
+		middleware.ValidateId("id", enum.ID_PREFIX_USER),
+		trashHandler.RestoreUserHandler,
+	)
+	trash.Delete(
+		"/users/:id",
+		middleware.ValidateId("id", enum.ID_PREFIX_USER),
+		trashHandler.PurgeUserHandler,
+	)
+	trash.Get("/blogs", trashHandler.FindBlogsHandler)
+	trash.Post(
+		"/blogs/:id/restore",
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		trashHandler.RestoreBlogHandler,
+	)
+	trash.Delete(
+		"/blogs/:id",
+		middleware.ValidateId("id", enum.ID_PREFIX_BLOG),
+		trashHandler.PurgeBlogHandler,
+	)
+
+}
@@ -26,13 +26,37 @@ func UserRouter(app fiber.Router, userHandler *handler.UserHandler) {
 		middleware.RoleMiddleware(enum.ROLE_USER, enum.ROLE_ADMIN),
 		userHandler.FindAllPaginateHandler,
 	)
-	user.Get("/:id", middleware.JWTMidleware, userHandler.FindByIdHandler)
+	user.Get(
+		"/cursor",
+		middleware.JWTMidleware,
+		middleware.RoleMiddleware(enum.ROLE_USER, enum.ROLE_ADMIN),
+		userHandler.FindAllCursorHandler,
+	)
+	user.Get(
+		"/:id",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_USER),
+		userHandler.FindByIdHandler,
+	)
 	user.Put("/refresh-token", userHandler.RefreshTokenHandler)
-	user.Put("/:id", middleware.JWTMidleware, userHandler.UpdateUserByIdHandler)
+	user.Put(
+		"/:id",
+		middleware.JWTMidleware,
+		middleware.ValidateId("id", enum.ID_PREFIX_USER),
+		userHandler.UpdateUserByIdHandler,
+	)
+	user.Put(
+		"/:id/password",
+		middleware.JWTMidleware,
+		middleware.RoleMiddleware(enum.ROLE_ADMIN),
+		middleware.ValidateId("id", enum.ID_PREFIX_USER),
+		userHandler.SetPasswordHandler,
+	)
 	user.Delete(
 		"/:id",
 		middleware.JWTMidleware,
 		middleware.RoleMiddleware(enum.ROLE_ADMIN),
+		middleware.ValidateId("id", enum.ID_PREFIX_USER),
 		userHandler.DeleteUserByIdHandler,
 	)
 
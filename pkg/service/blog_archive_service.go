@@ -0,0 +1,684 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"learn/fiber/config"
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/utils"
+	"mime"
+	"mime/multipart"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+const (
+	archivePostsDir    = "posts"
+	archiveMediaDir    = "media"
+	archiveMaxPosts    = 1000
+	archiveMaxFileSize = 10 * 1024 * 1024
+)
+
+// archiveMediaReference matches media paths written by ExportArchive, such
+// as ../media/20250101120000.png, the first group keeps what precedes it.
+var archiveMediaReference = regexp.MustCompile(`(^|[\s("'=])(?:\.\./|/)?media/([\w-]+(?:\.\w+)?)`)
+
+// blogFrontMatter is the YAML header of an exported post. Id and slug let an
+// archive be imported again without duplicating its posts.
+type blogFrontMatter struct {
+	Id         string           `yaml:"id,omitempty"`
+	Title      string           `yaml:"title"`
+	Slug       string           `yaml:"slug,omitempty"`
+	Date       time.Time        `yaml:"date,omitempty"`
+	Updated    time.Time        `yaml:"updated,omitempty"`
+	Format     enum.EBlogFormat `yaml:"format,omitempty"`
+	Language   enum.ELanguage   `yaml:"language,omitempty"`
+	Tags       []string         `yaml:"tags,omitempty"`
+	Categories []string         `yaml:"categories,omitempty"`
+	Image      string           `yaml:"image,omitempty"`
+}
+
+// ExportArchive zips the blogs of userId as posts/<date>-<slug>.md with YAML
+// front matter, plus the files they use from the file service under media/.
+func (b *blogService) ExportArchive(userId string, user model.JwtPayload) ([]byte, error) {
+	if userId == "" {
+		userId = user.Id
+	}
+
+	if userId != user.Id && user.Role != enum.ROLE_ADMIN {
+		return nil, fiber.NewError(fiber.StatusForbidden, "You can only export your own blogs")
+	}
+
+	if _, err := b.userRepository.FindById(userId); err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	blogs, err := b.repository.FindAllByUser(userId)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+	serveUrl := mediaServeUrl()
+	fileNames := map[string]bool{}
+	mediaKeys := []string{}
+
+	for _, blog := range blogs {
+		body, image := blog.Body, blog.Image
+		keys := []string{}
+
+		if serveUrl != "" {
+			body, keys = exportMediaReferences(body, serveUrl)
+
+			if key, ok := strings.CutPrefix(image, serveUrl); ok && fileKeyPattern.MatchString(key) {
+				image = "../" + archiveMediaDir + "/" + key
+				keys = append(keys, key)
+			}
+		}
+
+		meta := blogFrontMatter{
+			Id:       blog.Id,
+			Title:    blog.Title,
+			Slug:     utils.Slugify(blog.Title),
+			Date:     blog.CreatedAt.UTC(),
+			Updated:  blog.UpdatedAt.UTC(),
+			Format:   blog.Format,
+			Language: blog.Language,
+			Image:    image,
+		}
+
+		for _, tag := range blog.Tags {
+			meta.Tags = append(meta.Tags, tag.Name)
+		}
+
+		for _, category := range blog.Categories {
+			meta.Categories = append(meta.Categories, category.Slug)
+		}
+
+		content, err := utils.WriteFrontMatter(meta, body)
+
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+
+		if err := writeArchiveFile(archive, uniqueFileName(fileNames, blog.CreatedAt, meta.Slug), content); err != nil {
+			return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+
+		for _, key := range keys {
+			if !slices.Contains(mediaKeys, key) {
+				mediaKeys = append(mediaKeys, key)
+			}
+		}
+	}
+
+	// Missing files are left out rather than failing the whole export, the
+	// posts still reference them by path.
+	for _, key := range mediaKeys {
+		object, err := b.fileService.Serve(key)
+
+		if err != nil {
+			log.Warnf("Skipping media %s in export: %v", key, err)
+			continue
+		}
+
+		writer, err := archive.Create(archiveMediaDir + "/" + key)
+
+		if err == nil {
+			_, err = io.Copy(writer, object.Body)
+		}
+
+		object.Body.Close()
+
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// ImportArchive creates or updates the blogs of the current user from an
+// archive made by ExportArchive or a static site generator. Posts are matched
+// to existing blogs by id, then by slug, so importing twice changes nothing.
+func (b *blogService) ImportArchive(file *multipart.FileHeader, user model.JwtPayload) (*res.BlogImportResponse, error) {
+	reader, err := openArchive(file)
+
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := b.repository.FindAllByUser(user.Id)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	importer := &blogImporter{
+		service:   b,
+		validator: utils.NewValidator(),
+		userId:    user.Id,
+		serveUrl:  mediaServeUrl(),
+		byId:      map[string]*entity.Blog{},
+		bySlug:    map[string]*entity.Blog{},
+		media:     map[string]*zip.File{},
+		stored:    map[string]bool{},
+	}
+
+	for i := range existing {
+		importer.remember(&existing[i])
+	}
+
+	posts := []*zip.File{}
+
+	for _, entry := range reader.File {
+		name := entry.Name
+
+		if entry.FileInfo().IsDir() || strings.HasPrefix(name, "__MACOSX/") || strings.HasPrefix(path.Base(name), ".") {
+			continue
+		}
+
+		switch {
+		case path.Base(path.Dir(name)) == archiveMediaDir:
+			importer.media[path.Base(name)] = entry
+		case strings.EqualFold(path.Ext(name), ".md"), strings.EqualFold(path.Ext(name), ".markdown"):
+			posts = append(posts, entry)
+		}
+	}
+
+	if len(posts) > archiveMaxPosts {
+		return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("An archive can hold at most %d posts", archiveMaxPosts))
+	}
+
+	report := &res.BlogImportResponse{Items: make([]res.BlogImportItem, 0, len(posts))}
+
+	for _, post := range posts {
+		item := importer.importPost(post)
+
+		switch item.Status {
+		case enum.IMPORT_STATUS_CREATED:
+			report.Created++
+		case enum.IMPORT_STATUS_UPDATED:
+			report.Updated++
+		case enum.IMPORT_STATUS_UNCHANGED:
+			report.Unchanged++
+		default:
+			report.Failed++
+		}
+
+		report.Items = append(report.Items, item)
+	}
+
+	return report, nil
+}
+
+// blogImporter holds the state of one import, blogs created by earlier posts
+// of the archive are remembered so duplicates within it are not created.
+type blogImporter struct {
+	service   *blogService
+	validator *validator.Validate
+	userId    string
+	serveUrl  string
+	byId      map[string]*entity.Blog
+	bySlug    map[string]*entity.Blog
+	media     map[string]*zip.File
+	stored    map[string]bool
+}
+
+func (i *blogImporter) remember(blog *entity.Blog) {
+	i.byId[blog.Id] = blog
+	i.bySlug[utils.Slugify(blog.Title)] = blog
+}
+
+func (i *blogImporter) importPost(post *zip.File) res.BlogImportItem {
+	item := res.BlogImportItem{File: post.Name, Status: enum.IMPORT_STATUS_FAILED}
+
+	data, err := readArchiveFile(post)
+
+	if err != nil {
+		item.Error = err.Error()
+		return item
+	}
+
+	var meta blogFrontMatter
+
+	body, err := utils.ParseFrontMatter(data, &meta)
+
+	if err != nil {
+		item.Error = err.Error()
+		return item
+	}
+
+	title := strings.TrimSpace(meta.Title)
+	body = strings.TrimRight(body, "\n")
+	item.Title = title
+
+	if err := i.validate(&meta, title, body); err != nil {
+		item.Error = err.Error()
+		return item
+	}
+
+	body, err = i.importMediaReferences(body)
+
+	if err != nil {
+		item.Error = err.Error()
+		return item
+	}
+
+	image, err := i.importImage(meta.Image)
+
+	if err != nil {
+		item.Error = err.Error()
+		return item
+	}
+
+	tags, err := i.service.resolveTags(meta.Tags)
+
+	if err != nil {
+		item.Error = err.Error()
+		return item
+	}
+
+	categories, warnings, err := i.resolveCategories(meta.Categories)
+
+	if err != nil {
+		item.Error = err.Error()
+		return item
+	}
+
+	item.Warnings = warnings
+
+	blog := i.find(&meta, title)
+
+	if blog == nil {
+		blog = &entity.Blog{
+			Title:      title,
+			Body:       body,
+			Format:     meta.Format,
+			Image:      image,
+			UserId:     i.userId,
+			Language:   meta.Language,
+			Tags:       tags,
+			Categories: categories,
+		}
+
+		blog.CreatedAt = meta.Date
+		blog.UpdatedAt = meta.Updated
+
+		if err := i.service.checkDuplicateTitle(i.userId, title, ""); err != nil {
+			item.Error = err.Error()
+			return item
+		}
+
+		if err := i.service.repository.Create(blog); err != nil {
+			item.Error = err.Error()
+			return item
+		}
+
+		i.remember(blog)
+		item.Status, item.BlogId = enum.IMPORT_STATUS_CREATED, blog.Id
+
+		return item
+	}
+
+	item.BlogId = blog.Id
+
+	if blog.Title == title &&
+		strings.TrimRight(blog.Body, "\n") == body &&
+		blog.Image == image &&
+		blog.Format == meta.Format &&
+		blog.Language == meta.Language &&
+		sameTaxonomy(blog, tags, categories) {
+		item.Status = enum.IMPORT_STATUS_UNCHANGED
+		return item
+	}
+
+	if err := i.service.checkDuplicateTitle(i.userId, title, blog.Id); err != nil {
+		item.Error = err.Error()
+		return item
+	}
+
+	delete(i.bySlug, utils.Slugify(blog.Title))
+
+	blog.Title, blog.Body, blog.Image = title, body, image
+	blog.Format, blog.Language = meta.Format, meta.Language
+
+	if err := i.service.repository.Update(blog, i.userId); err != nil {
+		item.Error = err.Error()
+		return item
+	}
+
+	if err := i.service.repository.ReplaceTaxonomy(blog.Id, tags, categories); err != nil {
+		item.Error = err.Error()
+		return item
+	}
+
+	blog.Tags, blog.Categories = tags, categories
+	i.remember(blog)
+	item.Status = enum.IMPORT_STATUS_UPDATED
+
+	return item
+}
+
+// validate runs a post through CreateBlogDto and the banned words filter,
+// so an archive can not create a blog the API would refuse. It fills in the
+// default format and language.
+func (i *blogImporter) validate(meta *blogFrontMatter, title, body string) error {
+	if meta.Format == "" {
+		meta.Format = enum.BLOG_FORMAT_MARKDOWN
+	}
+
+	if meta.Language == "" {
+		meta.Language = enum.LANGUAGE_SIMPLE
+	}
+
+	payload := req.CreateBlogDto{
+		Title:    title,
+		Body:     body,
+		Format:   meta.Format,
+		Language: meta.Language,
+		Tags:     meta.Tags,
+	}
+
+	if err := utils.ValidateStruct(i.validator, &payload); err != nil {
+		return err
+	}
+
+	if err := rejectBannedWords("title", payload.Title); err != nil {
+		return err
+	}
+
+	return rejectBannedWords("body", payload.Body)
+}
+
+// find matches a post to a blog of the user by its id, then by the slug in
+// its front matter and finally by the slug of its title.
+func (i *blogImporter) find(meta *blogFrontMatter, title string) *entity.Blog {
+	if blog, ok := i.byId[meta.Id]; ok && meta.Id != "" {
+		return blog
+	}
+
+	if blog, ok := i.bySlug[utils.Slugify(meta.Slug)]; ok && meta.Slug != "" {
+		return blog
+	}
+
+	return i.bySlug[utils.Slugify(title)]
+}
+
+func (i *blogImporter) resolveCategories(slugs []string) ([]entity.Category, []string, error) {
+	normalized := make([]string, 0, len(slugs))
+
+	for _, slug := range slugs {
+		if slug = utils.Slugify(slug); slug != "" && !slices.Contains(normalized, slug) {
+			normalized = append(normalized, slug)
+		}
+	}
+
+	categories, err := i.service.categoryRepository.FindBySlugs(normalized)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	warnings := []string{}
+
+	for _, slug := range normalized {
+		found := slices.ContainsFunc(categories, func(category entity.Category) bool {
+			return category.Slug == slug
+		})
+
+		if !found {
+			warnings = append(warnings, fmt.Sprintf("category %s does not exist and was skipped", slug))
+		}
+	}
+
+	if categories == nil {
+		categories = []entity.Category{}
+	}
+
+	return categories, warnings, nil
+}
+
+// importImage accepts a media path from the archive or a URL of a file that
+// is already stored.
+func (i *blogImporter) importImage(image string) (string, error) {
+	image = strings.TrimSpace(image)
+
+	if matches := archiveMediaReference.FindStringSubmatch(image); matches != nil && matches[0] == image {
+		return i.storeMedia(matches[2])
+	}
+
+	resolved, err := i.service.resolveImage(image)
+
+	if err != nil {
+		return "", fmt.Errorf("image %s: %w", image, err)
+	}
+
+	return resolved, nil
+}
+
+// importMediaReferences uploads the media a body points at and rewrites
+// the paths to their URLs. Paths missing from the archive are kept as is.
+func (i *blogImporter) importMediaReferences(body string) (string, error) {
+	var failed error
+
+	body = archiveMediaReference.ReplaceAllStringFunc(body, func(match string) string {
+		parts := archiveMediaReference.FindStringSubmatch(match)
+
+		if _, ok := i.media[parts[2]]; !ok || failed != nil {
+			return match
+		}
+
+		url, err := i.storeMedia(parts[2])
+
+		if err != nil {
+			failed = err
+			return match
+		}
+
+		return parts[1] + url
+	})
+
+	return body, failed
+}
+
+// storeMedia uploads a file of the archive under its own key, a key that is
+// already stored is reused instead of overwritten.
+func (i *blogImporter) storeMedia(key string) (string, error) {
+	url := i.serveUrl + key
+
+	if i.stored[key] {
+		return url, nil
+	}
+
+	entry, ok := i.media[key]
+
+	if !ok || !fileKeyPattern.MatchString(key) || i.serveUrl == "" {
+		return "", fmt.Errorf("media %s is not in the archive", key)
+	}
+
+	exists, err := i.service.fileService.Exists(key)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !exists {
+		data, err := readArchiveFile(entry)
+
+		if err != nil {
+			return "", err
+		}
+
+		contentType := mime.TypeByExtension(path.Ext(key))
+
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		if err := i.service.fileService.Put(key, bytes.NewReader(data), contentType); err != nil {
+			return "", err
+		}
+	}
+
+	i.stored[key] = true
+
+	return url, nil
+}
+
+func openArchive(file *multipart.FileHeader) (*zip.Reader, error) {
+	content, err := file.Open()
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	defer content.Close()
+
+	data, err := io.ReadAll(content)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+
+	if err != nil {
+		return nil, utils.NewValidationError(fiber.StatusBadRequest, model.FieldError{
+			Field:   "file",
+			Message: "must be a zip archive",
+		})
+	}
+
+	return reader, nil
+}
+
+// readArchiveFile refuses entries that inflate beyond archiveMaxFileSize so a
+// small archive can not exhaust memory.
+func readArchiveFile(entry *zip.File) ([]byte, error) {
+	content, err := entry.Open()
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer content.Close()
+
+	data, err := io.ReadAll(io.LimitReader(content, archiveMaxFileSize+1))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > archiveMaxFileSize {
+		return nil, fmt.Errorf("%s is larger than %d MB", entry.Name, archiveMaxFileSize/1024/1024)
+	}
+
+	return data, nil
+}
+
+func writeArchiveFile(archive *zip.Writer, name string, content []byte) error {
+	writer, err := archive.Create(name)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(content)
+
+	return err
+}
+
+// uniqueFileName names a post after its date and slug like Jekyll does,
+// suffixing a number when two posts would share a name.
+func uniqueFileName(taken map[string]bool, date time.Time, slug string) string {
+	if slug == "" {
+		slug = "untitled"
+	}
+
+	base := archivePostsDir + "/" + date.UTC().Format("2006-01-02") + "-" + slug
+	name := base + ".md"
+
+	for n := 2; taken[name]; n++ {
+		name = fmt.Sprintf("%s-%d.md", base, n)
+	}
+
+	taken[name] = true
+
+	return name
+}
+
+// exportMediaReferences rewrites URLs of stored files in a body to paths in
+// the archive and returns the keys of those files.
+func exportMediaReferences(body, serveUrl string) (string, []string) {
+	pattern := regexp.MustCompile(regexp.QuoteMeta(serveUrl) + `([\w-]+(?:\.\w+)?)`)
+	keys := []string{}
+
+	body = pattern.ReplaceAllStringFunc(body, func(match string) string {
+		key := strings.TrimPrefix(match, serveUrl)
+		keys = append(keys, key)
+
+		return "../" + archiveMediaDir + "/" + key
+	})
+
+	return body, keys
+}
+
+// mediaServeUrl is S3_SERVE_URL with a trailing slash, or empty when media
+// is not configured.
+func mediaServeUrl() string {
+	serveUrl := strings.TrimRight(config.S3_SERVE_URL.GetValue(), "/")
+
+	if serveUrl == "" {
+		return ""
+	}
+
+	return serveUrl + "/"
+}
+
+func sameTaxonomy(blog *entity.Blog, tags []entity.Tag, categories []entity.Category) bool {
+	tagIds, currentTagIds := []string{}, []string{}
+	categoryIds, currentCategoryIds := []string{}, []string{}
+
+	for _, tag := range tags {
+		tagIds = append(tagIds, tag.Id)
+	}
+
+	for _, tag := range blog.Tags {
+		currentTagIds = append(currentTagIds, tag.Id)
+	}
+
+	for _, category := range categories {
+		categoryIds = append(categoryIds, category.Id)
+	}
+
+	for _, category := range blog.Categories {
+		currentCategoryIds = append(currentCategoryIds, category.Id)
+	}
+
+	slices.Sort(tagIds)
+	slices.Sort(currentTagIds)
+	slices.Sort(categoryIds)
+	slices.Sort(currentCategoryIds)
+
+	return slices.Equal(tagIds, currentTagIds) && slices.Equal(categoryIds, currentCategoryIds)
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// relatedPoolSize is how many related blogs are scored and cached per
+	// blog, it is also the largest limit a reader can ask for.
+	relatedPoolSize = 20
+	// relatedCacheEntries bounds the cache, it is emptied when full.
+	relatedCacheEntries = 1000
+)
+
+// relatedCache keeps the scored related blogs of a blog until any blog is
+// created, edited or deleted after they were computed, since a single change
+// can move a blog into or out of the related blogs of many others.
+type relatedCache struct {
+	mu      sync.Mutex
+	entries map[string]relatedCacheEntry
+}
+
+type relatedCacheEntry struct {
+	related    []repository.RelatedBlog
+	computedAt time.Time
+}
+
+func newRelatedCache() *relatedCache {
+	return &relatedCache{entries: make(map[string]relatedCacheEntry)}
+}
+
+func (c *relatedCache) get(key string, changedAt time.Time) ([]repository.RelatedBlog, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+
+	if !ok || changedAt.After(entry.computedAt) {
+		return nil, false
+	}
+
+	return entry.related, true
+}
+
+func (c *relatedCache) put(key string, related []repository.RelatedBlog, computedAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= relatedCacheEntries {
+		clear(c.entries)
+	}
+
+	c.entries[key] = relatedCacheEntry{related: related, computedAt: computedAt}
+}
+
+// FindRelated returns the blogs most similar to a blog by shared tags and
+// text. Scores are cached, the blogs themselves are loaded on every call so
+// visibility and translations always follow the viewer.
+func (b *blogService) FindRelated(id string, params *req.RelatedBlogRequest, viewerId string) ([]res.RelatedBlogResponse, error) {
+	blog, err := b.repository.FindEntityById(id)
+
+	if err != nil || (blog.HiddenAt != nil && blog.UserId != viewerId) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Blog not found")
+	}
+
+	changedAt, err := b.repository.LastChangedAt()
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	key := blog.Id + "|" + strconv.FormatBool(params.ExcludeAuthor)
+	related, ok := b.related.get(key, changedAt)
+
+	if !ok {
+		related, err = b.repository.FindRelated(blog.Id, params.ExcludeAuthor, relatedPoolSize)
+
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+
+		b.related.put(key, related, changedAt)
+	}
+
+	ids := make([]string, 0, len(related))
+	scores := make(map[string]repository.RelatedBlog, len(related))
+
+	for _, item := range related {
+		ids = append(ids, item.BlogId)
+		scores[item.BlogId] = item
+	}
+
+	blogs, err := b.repository.FindByIds(ids, viewerId)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if len(blogs) > params.Limit {
+		blogs = blogs[:params.Limit]
+	}
+
+	localized := make([]*res.FindBlogResponse, 0, len(blogs))
+
+	for i := range blogs {
+		localized = append(localized, &blogs[i])
+	}
+
+	if err := localizeBlogs(b.translationRepository, localized, params.Locales); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	responses := make([]res.RelatedBlogResponse, 0, len(blogs))
+
+	for _, blog := range blogs {
+		responses = append(responses, res.RelatedBlogResponse{
+			FindBlogResponse: blog,
+			SharedTags:       scores[blog.ID].SharedTags,
+			Score:            scores[blog.ID].Score,
+		})
+	}
+
+	return responses, nil
+}
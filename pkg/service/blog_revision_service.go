@@ -0,0 +1,151 @@
+package service
+
+import (
+	"fmt"
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+type BlogRevisionService interface {
+	FindAllPaginate(blogId string, pagination *model.PaginationRequest, user model.JwtPayload) (*model.MetaPagination, []res.BlogRevisionResponse, error)
+	FindByNumber(blogId string, number int, user model.JwtPayload) (*res.BlogRevisionDetailResponse, error)
+	Diff(blogId string, diff *req.BlogRevisionDiffRequest, user model.JwtPayload) (*res.BlogRevisionDiffResponse, error)
+	Restore(blogId string, number int, user model.JwtPayload) (*res.FindBlogResponse, error)
+}
+
+type blogRevisionService struct {
+	repository     *repository.BlogRevisionRepository
+	blogRepository *repository.BlogRepository
+	blogService    BlogService
+}
+
+func NewBlogRevisionService(
+	repository *repository.BlogRevisionRepository,
+	blogRepository *repository.BlogRepository,
+	blogService BlogService,
+) BlogRevisionService {
+	return &blogRevisionService{
+		repository:     repository,
+		blogRepository: blogRepository,
+		blogService:    blogService,
+	}
+}
+
+func (s *blogRevisionService) FindAllPaginate(blogId string, pagination *model.PaginationRequest, user model.JwtPayload) (*model.MetaPagination, []res.BlogRevisionResponse, error) {
+	if err := s.authorize(blogId, user); err != nil {
+		return nil, nil, err
+	}
+
+	revisions, total, err := s.repository.FindAllPagination(blogId, pagination.Page, pagination.Limit)
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	totalPage := (total + int64(pagination.Limit) - 1) / int64(pagination.Limit)
+
+	meta := &model.MetaPagination{
+		Page:      pagination.Page,
+		Limit:     pagination.Limit,
+		TotalPage: int(totalPage),
+		TotalData: int(total),
+	}
+
+	return meta, revisions, nil
+}
+
+func (s *blogRevisionService) FindByNumber(blogId string, number int, user model.JwtPayload) (*res.BlogRevisionDetailResponse, error) {
+	if err := s.authorize(blogId, user); err != nil {
+		return nil, err
+	}
+
+	revision, err := s.repository.FindByNumber(blogId, number)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("Revision %d not found", number))
+	}
+
+	return revision, nil
+}
+
+func (s *blogRevisionService) Diff(blogId string, diff *req.BlogRevisionDiffRequest, user model.JwtPayload) (*res.BlogRevisionDiffResponse, error) {
+	from, err := s.FindByNumber(blogId, diff.From, user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.FindByNumber(blogId, diff.To, user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	unified, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(revisionDocument(from)),
+		B:        difflib.SplitLines(revisionDocument(to)),
+		FromFile: fmt.Sprintf("revision %d", from.Number),
+		FromDate: from.CreatedAt.Format("2006-01-02 15:04:05"),
+		ToFile:   fmt.Sprintf("revision %d", to.Number),
+		ToDate:   to.CreatedAt.Format("2006-01-02 15:04:05"),
+		Context:  3,
+	})
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return &res.BlogRevisionDiffResponse{
+		From: from.Number,
+		To:   to.Number,
+		Diff: unified,
+	}, nil
+}
+
+// Restore copies an old revision back onto the blog. The restore is itself
+// recorded as a new revision, so history is never rewritten.
+func (s *blogRevisionService) Restore(blogId string, number int, user model.JwtPayload) (*res.FindBlogResponse, error) {
+	revision, err := s.FindByNumber(blogId, number, user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.blogService.UpdateBlog(blogId, &req.UpdateBlogDto{
+		Title:  revision.Title,
+		Body:   revision.Body,
+		Format: revision.Format,
+		Image:  revision.Image,
+	}, user)
+}
+
+func (s *blogRevisionService) authorize(blogId string, user model.JwtPayload) error {
+	blog, err := s.blogRepository.FindEntityById(blogId)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	if !canEditBlog(blog, user) {
+		return fiber.NewError(fiber.StatusForbidden, "You don't have permission to access the revisions of this blog")
+	}
+
+	return nil
+}
+
+// revisionDocument flattens a revision into the text that gets diffed, so
+// title, format and image changes show up next to body changes.
+func revisionDocument(revision *res.BlogRevisionDetailResponse) string {
+	return fmt.Sprintf(
+		"Title: %s\nFormat: %s\nImage: %s\n\n%s\n",
+		revision.Title,
+		revision.Format,
+		revision.Image,
+		revision.Body,
+	)
+}
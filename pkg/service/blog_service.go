@@ -1,58 +1,163 @@
 package service
 
 import (
+	"errors"
+	"fmt"
+	"learn/fiber/config"
+	"learn/fiber/pkg/enum"
 	"learn/fiber/pkg/model"
 	"learn/fiber/pkg/model/entity"
 	"learn/fiber/pkg/model/req"
 	"learn/fiber/pkg/model/res"
 	"learn/fiber/pkg/repository"
+	"learn/fiber/utils"
+	"mime/multipart"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// uniqueViolation is the Postgres error code of a unique index conflict.
+const uniqueViolation = "23505"
+
+// fileKeyPattern matches the flat keys FileService.Upload generates.
+var fileKeyPattern = regexp.MustCompile(`^[\w-]+(\.[\w]+)?$`)
+
 type BlogService interface {
-	CreateBlog(createBlogDto *req.CreateBlogDto, userId string) (*entity.Blog, error)
-	FindAllPaginate(pagination *model.PaginationRequest) (*model.MetaPagination, *[]res.FindBlogResponse, error)
-	FindById(id string) (*res.FindBlogResponse, error)
+	CreateBlog(createBlogDto *req.CreateBlogDto, userId string) (*res.FindBlogResponse, error)
+	FindAllPaginate(pagination *req.BlogPaginationRequest, viewerId string) (*model.MetaPagination, *[]res.FindBlogResponse, error)
+	FindAllCursor(pagination *req.BlogCursorRequest, viewerId string) (*model.MetaCursor, []res.FindBlogResponse, error)
+	FindFollowingFeed(userId string, pagination *model.PaginationRequest) (*model.MetaPagination, []res.FindBlogResponse, error)
+	FindById(id, viewerId string, locales []enum.ELocale) (*res.FindBlogResponse, error)
+	Search(search *req.SearchBlogRequest, viewerId string) (*model.MetaPagination, []res.SearchBlogResponse, error)
+	UpdateBlog(id string, payload *req.UpdateBlogDto, user model.JwtPayload) (*res.FindBlogResponse, error)
+	ExportArchive(userId string, user model.JwtPayload) ([]byte, error)
+	ImportArchive(file *multipart.FileHeader, user model.JwtPayload) (*res.BlogImportResponse, error)
+	FindRelated(id string, params *req.RelatedBlogRequest, viewerId string) ([]res.RelatedBlogResponse, error)
 }
 
 type blogService struct {
-	repository     *repository.BlogRepository
-	userRepository *repository.UserRepository
+	repository            *repository.BlogRepository
+	userRepository        *repository.UserRepository
+	tagRepository         *repository.TagRepository
+	categoryRepository    *repository.CategoryRepository
+	fileService           FileService
+	translationRepository *repository.BlogTranslationRepository
+	related               *relatedCache
 }
 
-func NewBlogService(repository *repository.BlogRepository, userRepository *repository.UserRepository) BlogService {
-	return &blogService{repository: repository, userRepository: userRepository}
+func NewBlogService(
+	repository *repository.BlogRepository,
+	userRepository *repository.UserRepository,
+	tagRepository *repository.TagRepository,
+	categoryRepository *repository.CategoryRepository,
+	fileService FileService,
+	translationRepository *repository.BlogTranslationRepository,
+) BlogService {
+	return &blogService{
+		repository:            repository,
+		userRepository:        userRepository,
+		tagRepository:         tagRepository,
+		categoryRepository:    categoryRepository,
+		fileService:           fileService,
+		translationRepository: translationRepository,
+		related:               newRelatedCache(),
+	}
 }
 
-func (b *blogService) CreateBlog(createBlogDto *req.CreateBlogDto, userId string) (*entity.Blog, error) {
+func (b *blogService) CreateBlog(createBlogDto *req.CreateBlogDto, userId string) (*res.FindBlogResponse, error) {
 	user, err := b.userRepository.FindById(userId)
 
 	if err != nil {
 		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
 	}
 
+	title := strings.TrimSpace(createBlogDto.Title)
+
+	if err := rejectBannedWords("title", title); err != nil {
+		return nil, err
+	}
+
+	if err := rejectBannedWords("body", createBlogDto.Body); err != nil {
+		return nil, err
+	}
+
+	if err := b.checkDuplicateTitle(user.Id, title, ""); err != nil {
+		return nil, err
+	}
+
+	image, err := b.resolveImage(createBlogDto.Image)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := b.resolveTags(createBlogDto.Tags)
+
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := b.resolveCategories(createBlogDto.CategoryIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if createBlogDto.Format == "" {
+		createBlogDto.Format = enum.BLOG_FORMAT_MARKDOWN
+	}
+
 	blog := &entity.Blog{
-		Title:  createBlogDto.Title,
-		Body:   createBlogDto.Body,
-		Image:  createBlogDto.Image,
-		UserId: user.Id,
+		Title:      title,
+		Body:       createBlogDto.Body,
+		Format:     createBlogDto.Format,
+		Image:      image,
+		UserId:     user.Id,
+		Language:   createBlogDto.Language,
+		Tags:       tags,
+		Categories: categories,
 	}
 
 	if err := b.repository.Create(blog); err != nil {
+		if isDuplicateTitle(err) {
+			return nil, duplicateTitleError()
+		}
+
 		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
-	return blog, nil
+	return b.findById(blog.Id, user.Id)
 }
 
-func (b *blogService) FindAllPaginate(pagination *model.PaginationRequest) (*model.MetaPagination, *[]res.FindBlogResponse, error) {
-	blogs, total, err := b.repository.FindAllPagination(pagination.Page, pagination.Limit, pagination.Search)
+func (b *blogService) FindAllPaginate(pagination *req.BlogPaginationRequest, viewerId string) (*model.MetaPagination, *[]res.FindBlogResponse, error) {
+	filter := repository.BlogFilter{
+		Search:     pagination.Search,
+		Tags:       splitSlugs(pagination.Tag),
+		Categories: splitSlugs(pagination.Category),
+		Filters:    pagination.Filters,
+		Sort:       pagination.Sort,
+	}
+
+	blogs, total, err := b.repository.FindAllPagination(pagination.Page, pagination.Limit, filter, viewerId)
 
 	if err != nil {
 		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
+	localized := make([]*res.FindBlogResponse, 0, len(*blogs))
+
+	for i := range *blogs {
+		localized = append(localized, &(*blogs)[i])
+	}
+
+	if err := localizeBlogs(b.translationRepository, localized, pagination.Locales); err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
 	totalPage := (total + int64(pagination.Limit) - 1) / int64(pagination.Limit)
 
 	meta := &model.MetaPagination{
@@ -65,8 +170,84 @@ func (b *blogService) FindAllPaginate(pagination *model.PaginationRequest) (*mod
 	return meta, blogs, nil
 }
 
-func (b *blogService) FindById(id string) (*res.FindBlogResponse, error) {
-	blog, err := b.repository.FindById(id)
+func (b *blogService) FindAllCursor(pagination *req.BlogCursorRequest, viewerId string) (*model.MetaCursor, []res.FindBlogResponse, error) {
+	cursor, err := decodeCursor(pagination.Cursor)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filter := repository.BlogFilter{
+		Search:     pagination.Search,
+		Tags:       splitSlugs(pagination.Tag),
+		Categories: splitSlugs(pagination.Category),
+	}
+
+	blogs, hasMore, err := b.repository.FindAllCursor(pagination.Limit, filter, cursor, viewerId)
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	var first, last *utils.Cursor
+
+	if len(blogs) > 0 {
+		if first, err = blogCursor(blogs[0]); err == nil {
+			last, err = blogCursor(blogs[len(blogs)-1])
+		}
+
+		if err != nil {
+			return nil, nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		}
+	}
+
+	meta, err := utils.NewMetaCursor(pagination.Limit, cursor, hasMore, first, last)
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return meta, blogs, nil
+}
+
+func (b *blogService) FindFollowingFeed(userId string, pagination *model.PaginationRequest) (*model.MetaPagination, []res.FindBlogResponse, error) {
+	blogs, total, err := b.repository.FindFollowingFeed(userId, pagination.Page, pagination.Limit, userId)
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	totalPage := (total + int64(pagination.Limit) - 1) / int64(pagination.Limit)
+
+	meta := &model.MetaPagination{
+		Page:      pagination.Page,
+		Limit:     pagination.Limit,
+		TotalPage: int(totalPage),
+		TotalData: int(total),
+	}
+
+	return meta, blogs, nil
+}
+
+// FindById serves the blog in the locale the reader prefers, see
+// localizeBlogs.
+func (b *blogService) FindById(id, viewerId string, locales []enum.ELocale) (*res.FindBlogResponse, error) {
+	blog, err := b.findById(id, viewerId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := localizeBlogs(b.translationRepository, []*res.FindBlogResponse{blog}, locales); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return blog, nil
+}
+
+// findById returns the blog as written, which is what its editors get back.
+func (b *blogService) findById(id, viewerId string) (*res.FindBlogResponse, error) {
+	blog, err := b.repository.FindById(id, viewerId)
 
 	if err != nil {
 		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
@@ -74,3 +255,236 @@ func (b *blogService) FindById(id string) (*res.FindBlogResponse, error) {
 
 	return blog, nil
 }
+
+func (b *blogService) Search(search *req.SearchBlogRequest, viewerId string) (*model.MetaPagination, []res.SearchBlogResponse, error) {
+	blogs, total, err := b.repository.Search(search.Query, search.Language, search.Page, search.Limit, viewerId)
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	totalPage := (total + int64(search.Limit) - 1) / int64(search.Limit)
+
+	meta := &model.MetaPagination{
+		Page:      search.Page,
+		Limit:     search.Limit,
+		TotalPage: int(totalPage),
+		TotalData: int(total),
+	}
+
+	return meta, blogs, nil
+}
+
+func (b *blogService) UpdateBlog(id string, payload *req.UpdateBlogDto, user model.JwtPayload) (*res.FindBlogResponse, error) {
+	blog, err := b.repository.FindEntityById(id)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	if !canEditBlog(blog, user) {
+		return nil, fiber.NewError(fiber.StatusForbidden, "You don't have permission to edit this blog")
+	}
+
+	title := strings.TrimSpace(payload.Title)
+
+	if err := b.checkDuplicateTitle(blog.UserId, title, blog.Id); err != nil {
+		return nil, err
+	}
+
+	// Only a changed image is checked so restoring an old revision still
+	// works after its file has been removed.
+	if payload.Image != blog.Image {
+		if blog.Image, err = b.resolveImage(payload.Image); err != nil {
+			return nil, err
+		}
+	}
+
+	blog.Title = title
+	blog.Body = payload.Body
+
+	if payload.Format != "" {
+		blog.Format = payload.Format
+	}
+
+	if err := b.repository.Update(blog, user.Id); err != nil {
+		if isDuplicateTitle(err) {
+			return nil, duplicateTitleError()
+		}
+
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return b.findById(blog.Id, user.Id)
+}
+
+func (b *blogService) checkDuplicateTitle(userId, title, excludeId string) error {
+	exists, err := b.repository.ExistsByTitle(userId, title, excludeId)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if exists {
+		return duplicateTitleError()
+	}
+
+	return nil
+}
+
+// isDuplicateTitle reports a write that lost the race against the check of
+// checkDuplicateTitle, the unique index on the lowered title caught it.
+func isDuplicateTitle(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == "idx_blogs_user_title"
+}
+
+func duplicateTitleError() error {
+	return utils.NewValidationError(fiber.StatusConflict, model.FieldError{
+		Field:   "title",
+		Message: "is already used by another of your blogs",
+	})
+}
+
+// resolveImage accepts a URL returned by the upload endpoint or the bare file
+// key from it, and always stores the full URL. The file must exist.
+func (b *blogService) resolveImage(image string) (string, error) {
+	image = strings.TrimSpace(image)
+
+	if image == "" {
+		return "", nil
+	}
+
+	serveUrl := strings.TrimRight(config.S3_SERVE_URL.GetValue(), "/") + "/"
+	key := strings.TrimPrefix(image, serveUrl)
+
+	if !fileKeyPattern.MatchString(key) {
+		return "", utils.NewValidationError(fiber.StatusBadRequest, model.FieldError{
+			Field:   "image",
+			Message: "must be a file uploaded to this server",
+		})
+	}
+
+	exists, err := b.fileService.Exists(key)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !exists {
+		return "", utils.NewValidationError(fiber.StatusBadRequest, model.FieldError{
+			Field:   "image",
+			Message: "file does not exist",
+		})
+	}
+
+	return serveUrl + key, nil
+}
+
+func (b *blogService) resolveTags(names []string) ([]entity.Tag, error) {
+	tags := []entity.Tag{}
+	seen := map[string]bool{}
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		slug := utils.Slugify(name)
+
+		if slug == "" || seen[slug] {
+			continue
+		}
+
+		seen[slug] = true
+
+		tag, err := b.tagRepository.FindOrCreate(name, slug)
+
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+
+		tags = append(tags, *tag)
+	}
+
+	return tags, nil
+}
+
+func (b *blogService) resolveCategories(ids []string) ([]entity.Category, error) {
+	if len(ids) == 0 {
+		return []entity.Category{}, nil
+	}
+
+	categories, err := b.categoryRepository.FindByIds(ids)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	found := map[string]bool{}
+
+	for _, category := range categories {
+		found[category.Id] = true
+	}
+
+	for _, id := range ids {
+		if !found[id] {
+			return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Category %s not found", id))
+		}
+	}
+
+	return categories, nil
+}
+
+// splitSlugs turns a comma separated query value such as "go,web-dev" into
+// normalized slugs, so filters accept either names or slugs.
+func splitSlugs(value string) []string {
+	slugs := []string{}
+
+	for _, part := range strings.Split(value, ",") {
+		if slug := utils.Slugify(part); slug != "" {
+			slugs = append(slugs, slug)
+		}
+	}
+
+	return slugs
+}
+
+// canEditBlog allows the owner, co-authors, editors and admins, it expects
+// the blog to be loaded with FindEntityById so its contributors are known.
+func canEditBlog(blog *entity.Blog, user model.JwtPayload) bool {
+	if blog.UserId == user.Id || user.Role == enum.ROLE_ADMIN {
+		return true
+	}
+
+	for _, contributor := range blog.Contributors {
+		if contributor.UserId == user.Id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// decodeCursor returns nil for the first page, when no cursor was sent.
+func decodeCursor(value string) (*utils.Cursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	cursor, err := utils.DecodeCursor(value)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Invalid cursor")
+	}
+
+	return cursor, nil
+}
+
+func blogCursor(blog res.FindBlogResponse) (*utils.Cursor, error) {
+	createdAt, err := time.Parse(time.RFC3339Nano, blog.CreatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &utils.Cursor{CreatedAt: createdAt, Id: blog.ID}, nil
+}
@@ -0,0 +1,266 @@
+package service
+
+import (
+	"errors"
+	"learn/fiber/config"
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+	"learn/fiber/utils"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type BlogTranslationService interface {
+	FindTranslations(blogId string) ([]res.BlogTranslationResponse, error)
+	SaveTranslation(blogId, locale string, payload *req.BlogTranslationDto, user model.JwtPayload) (*res.BlogTranslationResponse, bool, error)
+	DeleteTranslation(blogId, locale string, user model.JwtPayload) error
+}
+
+type blogTranslationService struct {
+	repository     *repository.BlogTranslationRepository
+	blogRepository *repository.BlogRepository
+}
+
+func NewBlogTranslationService(repository *repository.BlogTranslationRepository, blogRepository *repository.BlogRepository) BlogTranslationService {
+	return &blogTranslationService{repository: repository, blogRepository: blogRepository}
+}
+
+func (s *blogTranslationService) FindTranslations(blogId string) ([]res.BlogTranslationResponse, error) {
+	if _, err := s.blogRepository.FindEntityById(blogId); err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	translations, err := s.repository.FindByBlogs([]string{blogId})
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	responses := make([]res.BlogTranslationResponse, 0, len(translations))
+
+	for _, translation := range translations {
+		responses = append(responses, transformTranslationResponse(translation))
+	}
+
+	return responses, nil
+}
+
+// SaveTranslation adds or replaces the translation of a blog in locale and
+// reports whether it was created. Whoever can edit the blog can translate it.
+func (s *blogTranslationService) SaveTranslation(blogId, locale string, payload *req.BlogTranslationDto, user model.JwtPayload) (*res.BlogTranslationResponse, bool, error) {
+	blog, target, err := s.findEditable(blogId, locale, user)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	if target == blogLocale(blog.Language) {
+		return nil, false, utils.NewValidationError(fiber.StatusBadRequest, model.FieldError{
+			Field:   "locale",
+			Message: "is the locale the blog is written in, edit the blog instead",
+		})
+	}
+
+	title := strings.TrimSpace(payload.Title)
+
+	if err := rejectBannedWords("title", title); err != nil {
+		return nil, false, err
+	}
+
+	if err := rejectBannedWords("body", payload.Body); err != nil {
+		return nil, false, err
+	}
+
+	slug := utils.Slugify(payload.Slug)
+
+	if slug == "" {
+		slug = utils.Slugify(title)
+	}
+
+	_, err = s.repository.FindByBlogAndLocale(blog.Id, target)
+	created := errors.Is(err, gorm.ErrRecordNotFound)
+
+	if err != nil && !created {
+		return nil, false, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	translation := entity.BlogTranslation{
+		BlogId: blog.Id,
+		Locale: target,
+		Title:  title,
+		Body:   payload.Body,
+		Slug:   slug,
+	}
+
+	if err := s.repository.Save(&translation); err != nil {
+		return nil, false, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	saved, err := s.repository.FindByBlogAndLocale(blog.Id, target)
+
+	if err != nil {
+		return nil, false, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	response := transformTranslationResponse(*saved)
+
+	return &response, created, nil
+}
+
+func (s *blogTranslationService) DeleteTranslation(blogId, locale string, user model.JwtPayload) error {
+	blog, target, err := s.findEditable(blogId, locale, user)
+
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.repository.Delete(blog.Id, target)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if !deleted {
+		return fiber.NewError(fiber.StatusNotFound, "Translation not found")
+	}
+
+	return nil
+}
+
+func (s *blogTranslationService) findEditable(blogId, locale string, user model.JwtPayload) (*entity.Blog, enum.ELocale, error) {
+	target := enum.ELocale(locale)
+
+	if !slices.Contains(enum.Locales, target) {
+		return nil, "", utils.NewValidationError(fiber.StatusBadRequest, model.FieldError{
+			Field:   "locale",
+			Message: "must be one of en, id",
+		})
+	}
+
+	blog, err := s.blogRepository.FindEntityById(blogId)
+
+	if err != nil {
+		return nil, "", fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	if !canEditBlog(blog, user) {
+		return nil, "", fiber.NewError(fiber.StatusForbidden, "You don't have permission to edit this blog")
+	}
+
+	return blog, target, nil
+}
+
+// localizeBlogs serves every blog in the first preferred locale it is
+// available in, falling back to DEFAULT_LOCALE and then to the locale it
+// was written in. Translated bodies are rendered again.
+func localizeBlogs(repository *repository.BlogTranslationRepository, blogs []*res.FindBlogResponse, preferred []enum.ELocale) error {
+	ids := make([]string, 0, len(blogs))
+
+	for _, blog := range blogs {
+		ids = append(ids, blog.ID)
+	}
+
+	translations, err := repository.FindByBlogs(ids)
+
+	if err != nil {
+		return err
+	}
+
+	byBlog := map[string]map[enum.ELocale]entity.BlogTranslation{}
+
+	for _, translation := range translations {
+		if byBlog[translation.BlogId] == nil {
+			byBlog[translation.BlogId] = map[enum.ELocale]entity.BlogTranslation{}
+		}
+
+		byBlog[translation.BlogId][translation.Locale] = translation
+	}
+
+	wanted := append(slices.Clone(preferred), defaultLocale())
+
+	for _, blog := range blogs {
+		original := blogLocale(enum.ELanguage(blog.Language))
+		available := byBlog[blog.ID]
+
+		blog.Slug = utils.Slugify(blog.Title)
+		blog.Locale = string(original)
+		blog.Locales = []string{string(original)}
+
+		for _, locale := range enum.Locales {
+			if _, ok := available[locale]; ok {
+				blog.Locales = append(blog.Locales, string(locale))
+			}
+		}
+
+		for _, locale := range wanted {
+			if locale == original {
+				break
+			}
+
+			translation, ok := available[locale]
+
+			if !ok {
+				continue
+			}
+
+			rendered, err := utils.RenderBody(translation.Body, enum.EBlogFormat(blog.Format))
+
+			if err != nil {
+				return err
+			}
+
+			blog.Title = translation.Title
+			blog.Body = translation.Body
+			blog.Slug = translation.Slug
+			blog.Locale = string(locale)
+			blog.BodyHtml = rendered.Html
+			blog.Toc = rendered.Toc
+			blog.ReadingTime = rendered.ReadingTime
+
+			break
+		}
+	}
+
+	return nil
+}
+
+// blogLocale is the locale a blog is written in, told by the text search
+// language chosen for it.
+func blogLocale(language enum.ELanguage) enum.ELocale {
+	switch language {
+	case enum.LANGUAGE_ENGLISH:
+		return enum.LOCALE_ENGLISH
+	case enum.LANGUAGE_INDONESIAN:
+		return enum.LOCALE_INDONESIAN
+	default:
+		return defaultLocale()
+	}
+}
+
+// defaultLocale reads DEFAULT_LOCALE, most readers are Indonesian so it
+// falls back to id.
+func defaultLocale() enum.ELocale {
+	if locale, ok := utils.ParseLocale(config.DEFAULT_LOCALE.GetValue()); ok {
+		return locale
+	}
+
+	return enum.LOCALE_INDONESIAN
+}
+
+func transformTranslationResponse(translation entity.BlogTranslation) res.BlogTranslationResponse {
+	return res.BlogTranslationResponse{
+		Locale:    string(translation.Locale),
+		Title:     translation.Title,
+		Slug:      translation.Slug,
+		Body:      translation.Body,
+		CreatedAt: translation.CreatedAt,
+		UpdatedAt: translation.UpdatedAt,
+	}
+}
@@ -0,0 +1,331 @@
+package service
+
+import (
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+const (
+	// viewWindow is how long repeated views of a blog by the same visitor
+	// count as a single view.
+	viewWindow        = 30 * time.Minute
+	viewBufferSize    = 4096
+	viewBatchSize     = 500
+	viewFlushInterval = 10 * time.Second
+	viewStatsDays     = 30
+	viewStatsMaxDays  = 366
+	viewReferrers     = 10
+	dayLayout         = "2006-01-02"
+	directReferrer    = "direct"
+)
+
+// BlogView is a single view as seen by the handler, Visitor is the hash
+// from utils.HashVisitor.
+type BlogView struct {
+	BlogId   string
+	Visitor  string
+	Referrer string
+	ViewedAt time.Time
+}
+
+type BlogViewService interface {
+	Track(view BlogView)
+	FindStats(blogId string, params *req.BlogViewStatsRequest, user model.JwtPayload) (*res.BlogViewStatsResponse, error)
+	Close()
+}
+
+type blogViewService struct {
+	repository     *repository.BlogViewRepository
+	blogRepository *repository.BlogRepository
+	views          chan BlogView
+	stop           chan struct{}
+	done           chan struct{}
+	closeOnce      sync.Once
+}
+
+// NewBlogViewService starts the goroutine that deduplicates tracked views
+// and writes them in batches, Close flushes what is still buffered.
+func NewBlogViewService(repository *repository.BlogViewRepository, blogRepository *repository.BlogRepository) BlogViewService {
+	s := &blogViewService{
+		repository:     repository,
+		blogRepository: blogRepository,
+		views:          make(chan BlogView, viewBufferSize),
+		stop:           make(chan struct{}),
+		done:           make(chan struct{}),
+	}
+
+	go s.run()
+
+	return s
+}
+
+// Track never blocks the request, views are dropped when the buffer is full
+// rather than slowing down reads.
+func (s *blogViewService) Track(view BlogView) {
+	select {
+	case s.views <- view:
+	default:
+		log.Warnf("View buffer is full, dropping view of blog %s", view.BlogId)
+	}
+}
+
+func (s *blogViewService) Close() {
+	s.closeOnce.Do(func() {
+		close(s.stop)
+		<-s.done
+	})
+}
+
+func (s *blogViewService) run() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(viewFlushInterval)
+	defer ticker.Stop()
+
+	seen := make(map[string]time.Time)
+	pending := make([]BlogView, 0, viewBatchSize)
+
+	accept := func(view BlogView) {
+		key := view.BlogId + "|" + view.Visitor
+
+		if last, ok := seen[key]; ok && view.ViewedAt.Sub(last) < viewWindow {
+			return
+		}
+
+		seen[key] = view.ViewedAt
+		pending = append(pending, view)
+	}
+
+	flush := func() {
+		if len(pending) == 0 {
+			return
+		}
+
+		if err := s.repository.SaveBatch(aggregateViews(pending)); err != nil {
+			log.Errorf("Failed to save %d blog views: %v", len(pending), err)
+		}
+
+		pending = pending[:0]
+	}
+
+	for {
+		select {
+		case view := <-s.views:
+			accept(view)
+
+			if len(pending) >= viewBatchSize {
+				flush()
+			}
+		case now := <-ticker.C:
+			flush()
+
+			for key, last := range seen {
+				if now.Sub(last) >= viewWindow {
+					delete(seen, key)
+				}
+			}
+		case <-s.stop:
+			for {
+				select {
+				case view := <-s.views:
+					accept(view)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *blogViewService) FindStats(blogId string, params *req.BlogViewStatsRequest, user model.JwtPayload) (*res.BlogViewStatsResponse, error) {
+	blog, err := s.blogRepository.FindEntityById(blogId)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	if !canEditBlog(blog, user) {
+		return nil, fiber.NewError(fiber.StatusForbidden, "You don't have permission to see the stats of this blog")
+	}
+
+	from, to, err := statsRange(params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	limit := params.Referrers
+
+	if limit <= 0 {
+		limit = viewReferrers
+	}
+
+	stats, err := s.repository.FindDaily(blog.Id, from, to)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	uniqueVisitors, err := s.repository.CountUniqueVisitors(blog.Id, from, to)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	referrers, err := s.repository.FindTopReferrers(blog.Id, from, to, limit)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	byDay := make(map[string]entity.BlogViewStat, len(stats))
+
+	for _, stat := range stats {
+		byDay[stat.Day.Format(dayLayout)] = stat
+	}
+
+	response := &res.BlogViewStatsResponse{
+		BlogId:         blog.Id,
+		From:           from.Format(dayLayout),
+		To:             to.Format(dayLayout),
+		UniqueVisitors: uniqueVisitors,
+		Daily:          make([]res.BlogViewDailyResponse, 0, len(stats)),
+		TopReferrers:   referrers,
+	}
+
+	// Days without views are filled in so clients can chart the series as is.
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		stat := byDay[day.Format(dayLayout)]
+
+		response.Views += stat.Views
+		response.Daily = append(response.Daily, res.BlogViewDailyResponse{
+			Day:            day.Format(dayLayout),
+			Views:          stat.Views,
+			UniqueVisitors: stat.UniqueVisitors,
+		})
+	}
+
+	return response, nil
+}
+
+// statsRange defaults to the last 30 days and caps the range at a year.
+func statsRange(params *req.BlogViewStatsRequest) (time.Time, time.Time, error) {
+	to := truncateDay(time.Now())
+
+	if params.To != "" {
+		to, _ = time.ParseInLocation(dayLayout, params.To, time.Local)
+	}
+
+	from := to.AddDate(0, 0, 1-viewStatsDays)
+
+	if params.From != "" {
+		from, _ = time.ParseInLocation(dayLayout, params.From, time.Local)
+	}
+
+	if from.After(to) {
+		return from, to, fiber.NewError(fiber.StatusBadRequest, "from must not be after to")
+	}
+
+	if to.Sub(from) >= viewStatsMaxDays*24*time.Hour {
+		return from, to, fiber.NewError(fiber.StatusBadRequest, "The range can span at most 366 days")
+	}
+
+	return from, to, nil
+}
+
+// aggregateViews folds deduplicated views into the rows of the daily
+// aggregate tables.
+func aggregateViews(views []BlogView) *repository.BlogViewBatch {
+	type dayKey struct {
+		blogId string
+		day    string
+	}
+
+	type referrerKey struct {
+		dayKey
+		referrer string
+	}
+
+	type visitorKey struct {
+		dayKey
+		visitor string
+	}
+
+	stats := make(map[dayKey]*entity.BlogViewStat)
+	referrers := make(map[referrerKey]*entity.BlogViewReferrer)
+	visitors := make(map[visitorKey]bool)
+	batch := &repository.BlogViewBatch{}
+
+	for _, view := range views {
+		day := truncateDay(view.ViewedAt)
+		key := dayKey{blogId: view.BlogId, day: day.Format(dayLayout)}
+
+		stat, ok := stats[key]
+
+		if !ok {
+			stat = &entity.BlogViewStat{BlogId: view.BlogId, Day: day}
+			stats[key] = stat
+		}
+
+		stat.Views++
+
+		if vKey := (visitorKey{key, view.Visitor}); !visitors[vKey] {
+			visitors[vKey] = true
+			stat.UniqueVisitors++
+			batch.Visitors = append(batch.Visitors, entity.BlogViewVisitor{BlogId: view.BlogId, Day: day, Visitor: view.Visitor})
+		}
+
+		rKey := referrerKey{key, referrerHost(view.Referrer)}
+		referrer, ok := referrers[rKey]
+
+		if !ok {
+			referrer = &entity.BlogViewReferrer{BlogId: view.BlogId, Day: day, Referrer: rKey.referrer}
+			referrers[rKey] = referrer
+		}
+
+		referrer.Views++
+	}
+
+	for _, stat := range stats {
+		batch.Stats = append(batch.Stats, *stat)
+	}
+
+	for _, referrer := range referrers {
+		batch.Referrers = append(batch.Referrers, *referrer)
+	}
+
+	return batch
+}
+
+// referrerHost groups referrers by host, paths and query strings would make
+// every search result page its own referrer.
+func referrerHost(referrer string) string {
+	parsed, err := url.Parse(strings.TrimSpace(referrer))
+
+	if err != nil || parsed.Hostname() == "" {
+		return directReferrer
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+
+	if len(host) > 255 {
+		host = host[:255]
+	}
+
+	return host
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
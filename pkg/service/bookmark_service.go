@@ -0,0 +1,218 @@
+package service
+
+import (
+	"errors"
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+	"learn/fiber/utils"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type BookmarkService interface {
+	ToggleBookmark(blogId string, payload *req.BookmarkDto, userId string) (*res.BookmarkResponse, error)
+	RemoveBookmark(blogId, userId string) (*res.BookmarkResponse, error)
+	FindBookmarks(userId string, pagination *req.BookmarkPaginationRequest) (*model.MetaPagination, []res.FindBlogResponse, error)
+	FindFolders(userId string) ([]res.BookmarkFolderResponse, error)
+	CreateFolder(payload *req.BookmarkFolderDto, userId string) (*res.BookmarkFolderResponse, error)
+	UpdateFolder(folderId string, payload *req.BookmarkFolderDto, userId string) (*res.BookmarkFolderResponse, error)
+	DeleteFolder(folderId, userId string) error
+}
+
+type bookmarkService struct {
+	repository     *repository.BookmarkRepository
+	blogRepository *repository.BlogRepository
+}
+
+func NewBookmarkService(repository *repository.BookmarkRepository, blogRepository *repository.BlogRepository) BookmarkService {
+	return &bookmarkService{repository: repository, blogRepository: blogRepository}
+}
+
+// ToggleBookmark removes the bookmark when it is sent again for the same
+// folder, and otherwise creates it or moves it to the new folder.
+func (s *bookmarkService) ToggleBookmark(blogId string, payload *req.BookmarkDto, userId string) (*res.BookmarkResponse, error) {
+	if blog, err := s.blogRepository.FindEntityById(blogId); err != nil || (blog.HiddenAt != nil && blog.UserId != userId) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Blog not found")
+	}
+
+	folderId := payload.FolderId
+
+	if folderId != nil && *folderId == "" {
+		folderId = nil
+	}
+
+	if folderId != nil {
+		if _, err := s.findFolder(*folderId, userId); err != nil {
+			return nil, err
+		}
+	}
+
+	existing, err := s.repository.FindByUserAndBlog(userId, blogId)
+
+	switch {
+	case err == nil && sameFolder(existing.FolderId, folderId):
+		if err := s.repository.Delete(userId, blogId); err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+
+		return &res.BookmarkResponse{BlogId: blogId}, nil
+	case err == nil:
+		err = s.repository.MoveToFolder(userId, blogId, folderId)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		err = s.repository.Create(&entity.Bookmark{UserId: userId, BlogId: blogId, FolderId: folderId})
+	}
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return &res.BookmarkResponse{BlogId: blogId, Bookmarked: true, FolderId: folderId}, nil
+}
+
+func (s *bookmarkService) RemoveBookmark(blogId, userId string) (*res.BookmarkResponse, error) {
+	if err := s.repository.Delete(userId, blogId); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return &res.BookmarkResponse{BlogId: blogId}, nil
+}
+
+func (s *bookmarkService) FindBookmarks(userId string, pagination *req.BookmarkPaginationRequest) (*model.MetaPagination, []res.FindBlogResponse, error) {
+	if pagination.FolderId != "" {
+		if _, err := s.findFolder(pagination.FolderId, userId); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	blogs, total, err := s.blogRepository.FindBookmarked(userId, pagination.FolderId, pagination.Page, pagination.Limit)
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	totalPage := (total + int64(pagination.Limit) - 1) / int64(pagination.Limit)
+
+	meta := &model.MetaPagination{
+		Page:      pagination.Page,
+		Limit:     pagination.Limit,
+		TotalPage: int(totalPage),
+		TotalData: int(total),
+	}
+
+	return meta, blogs, nil
+}
+
+func (s *bookmarkService) FindFolders(userId string) ([]res.BookmarkFolderResponse, error) {
+	folders, err := s.repository.FindFolders(userId)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return folders, nil
+}
+
+func (s *bookmarkService) CreateFolder(payload *req.BookmarkFolderDto, userId string) (*res.BookmarkFolderResponse, error) {
+	name := strings.TrimSpace(payload.Name)
+
+	if err := s.checkDuplicateFolder(userId, name, ""); err != nil {
+		return nil, err
+	}
+
+	folder := entity.BookmarkFolder{UserId: userId, Name: name}
+
+	if err := s.repository.CreateFolder(&folder); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return &res.BookmarkFolderResponse{Id: folder.Id, Name: folder.Name, CreatedAt: folder.CreatedAt}, nil
+}
+
+func (s *bookmarkService) UpdateFolder(folderId string, payload *req.BookmarkFolderDto, userId string) (*res.BookmarkFolderResponse, error) {
+	folder, err := s.findFolder(folderId, userId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(payload.Name)
+
+	if err := s.checkDuplicateFolder(userId, name, folder.Id); err != nil {
+		return nil, err
+	}
+
+	folder.Name = name
+
+	if err := s.repository.UpdateFolder(folder); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	folders, err := s.repository.FindFolders(userId)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	for _, found := range folders {
+		if found.Id == folder.Id {
+			return &found, nil
+		}
+	}
+
+	return nil, fiber.NewError(fiber.StatusNotFound, "Folder not found")
+}
+
+func (s *bookmarkService) DeleteFolder(folderId, userId string) error {
+	folder, err := s.findFolder(folderId, userId)
+
+	if err != nil {
+		return err
+	}
+
+	if err := s.repository.DeleteFolder(folder.Id); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return nil
+}
+
+// findFolder hides folders of other users behind the same not found error.
+func (s *bookmarkService) findFolder(folderId, userId string) (*entity.BookmarkFolder, error) {
+	folder, err := s.repository.FindFolderById(folderId)
+
+	if err != nil || folder.UserId != userId {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Folder not found")
+	}
+
+	return folder, nil
+}
+
+func (s *bookmarkService) checkDuplicateFolder(userId, name, excludeId string) error {
+	exists, err := s.repository.ExistsFolderName(userId, name, excludeId)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if exists {
+		return utils.NewValidationError(fiber.StatusConflict, model.FieldError{
+			Field:   "name",
+			Message: "is already used by another of your folders",
+		})
+	}
+
+	return nil
+}
+
+func sameFolder(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return *a == *b
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+	"learn/fiber/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CategoryService interface {
+	CreateCategory(payload *req.CategoryDto) (*res.CategoryResponse, error)
+	FindAll() ([]res.CategoryWithCountResponse, error)
+	UpdateCategoryById(id string, payload *req.CategoryDto) (*res.CategoryResponse, error)
+	DeleteCategoryById(id string) error
+}
+
+type categoryService struct {
+	repository *repository.CategoryRepository
+}
+
+func NewCategoryService(repository *repository.CategoryRepository) CategoryService {
+	return &categoryService{repository: repository}
+}
+
+func (s *categoryService) CreateCategory(payload *req.CategoryDto) (*res.CategoryResponse, error) {
+	slug := utils.Slugify(payload.Name)
+
+	if slug == "" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Category name must contain at least one letter or number")
+	}
+
+	category := entity.Category{
+		Name:        payload.Name,
+		Slug:        slug,
+		Description: payload.Description,
+	}
+
+	if err := s.repository.Create(&category); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	categoryResponse := transformCategoryResponse(category)
+
+	return &categoryResponse, nil
+}
+
+func (s *categoryService) FindAll() ([]res.CategoryWithCountResponse, error) {
+	categories, err := s.repository.FindAllWithCount()
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return categories, nil
+}
+
+func (s *categoryService) UpdateCategoryById(id string, payload *req.CategoryDto) (*res.CategoryResponse, error) {
+	category, err := s.repository.FindById(id)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	slug := utils.Slugify(payload.Name)
+
+	if slug == "" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Category name must contain at least one letter or number")
+	}
+
+	category.Name = payload.Name
+	category.Slug = slug
+	category.Description = payload.Description
+
+	if err := s.repository.Update(category); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	categoryResponse := transformCategoryResponse(*category)
+
+	return &categoryResponse, nil
+}
+
+func (s *categoryService) DeleteCategoryById(id string) error {
+	if _, err := s.repository.FindById(id); err != nil {
+		return fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	if err := s.repository.Delete(id); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return nil
+}
+
+func transformCategoryResponse(category entity.Category) res.CategoryResponse {
+	return res.CategoryResponse{
+		Id:          category.Id,
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Description: category.Description,
+	}
+}
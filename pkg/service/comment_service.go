@@ -0,0 +1,227 @@
+package service
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// commentEditWindow is how long an author may edit or delete their own
+// comment after posting it. Admins can always remove comments.
+const commentEditWindow = 15 * time.Minute
+
+type CommentService interface {
+	CreateComment(blogId string, payload *req.CreateCommentDto, userId string) (*res.CommentResponse, error)
+	FindAllPaginate(blogId string, pagination *req.CommentPaginationRequest, viewerId string) (*model.MetaPagination, []res.CommentResponse, error)
+	UpdateComment(blogId, commentId string, payload *req.UpdateCommentDto, user model.JwtPayload) (*res.CommentResponse, error)
+	DeleteComment(blogId, commentId string, user model.JwtPayload) error
+}
+
+type commentService struct {
+	repository     *repository.CommentRepository
+	blogRepository *repository.BlogRepository
+}
+
+func NewCommentService(repository *repository.CommentRepository, blogRepository *repository.BlogRepository) CommentService {
+	return &commentService{repository: repository, blogRepository: blogRepository}
+}
+
+func (s *commentService) CreateComment(blogId string, payload *req.CreateCommentDto, userId string) (*res.CommentResponse, error) {
+	if blog, err := s.blogRepository.FindEntityById(blogId); err != nil || (blog.HiddenAt != nil && blog.UserId != userId) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Blog not found")
+	}
+
+	if err := rejectBannedWords("body", payload.Body); err != nil {
+		return nil, err
+	}
+
+	if payload.ParentId != nil && *payload.ParentId != "" {
+		parent, err := s.repository.FindById(*payload.ParentId)
+
+		if err != nil || parent.BlogId != blogId {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "Parent comment not found on this blog")
+		}
+	} else {
+		payload.ParentId = nil
+	}
+
+	comment := entity.Comment{
+		BlogId:   blogId,
+		UserId:   userId,
+		ParentId: payload.ParentId,
+		Body:     payload.Body,
+	}
+
+	if err := s.repository.Create(&comment); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return s.findCommentResponse(comment.Id, userId)
+}
+
+func (s *commentService) FindAllPaginate(blogId string, pagination *req.CommentPaginationRequest, viewerId string) (*model.MetaPagination, []res.CommentResponse, error) {
+	if blog, err := s.blogRepository.FindEntityById(blogId); err != nil || (blog.HiddenAt != nil && blog.UserId != viewerId) {
+		return nil, nil, fiber.NewError(fiber.StatusNotFound, "Blog not found")
+	}
+
+	var comments []res.CommentResponse
+	var total int64
+	var err error
+
+	if pagination.Mode == "flat" {
+		comments, total, err = s.repository.FindAllPagination(blogId, pagination.Page, pagination.Limit, viewerId)
+
+		if err == nil {
+			err = s.repository.AttachReactions(comments, viewerId)
+		}
+	} else {
+		comments, total, err = s.findThreads(blogId, pagination.Page, pagination.Limit, viewerId)
+	}
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	totalPage := (total + int64(pagination.Limit) - 1) / int64(pagination.Limit)
+
+	meta := &model.MetaPagination{
+		Page:      pagination.Page,
+		Limit:     pagination.Limit,
+		TotalPage: int(totalPage),
+		TotalData: int(total),
+	}
+
+	return meta, comments, nil
+}
+
+func (s *commentService) UpdateComment(blogId, commentId string, payload *req.UpdateCommentDto, user model.JwtPayload) (*res.CommentResponse, error) {
+	comment, err := s.findBlogComment(blogId, commentId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.UserId != user.Id {
+		return nil, fiber.NewError(fiber.StatusForbidden, "You can only edit your own comment")
+	}
+
+	if time.Since(comment.CreatedAt) > commentEditWindow {
+		return nil, fiber.NewError(fiber.StatusForbidden, "Comment can no longer be edited")
+	}
+
+	comment.Body = payload.Body
+
+	if err := s.repository.Update(comment); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return s.findCommentResponse(comment.Id, user.Id)
+}
+
+func (s *commentService) DeleteComment(blogId, commentId string, user model.JwtPayload) error {
+	comment, err := s.findBlogComment(blogId, commentId)
+
+	if err != nil {
+		return err
+	}
+
+	if user.Role != enum.ROLE_ADMIN {
+		if comment.UserId != user.Id {
+			return fiber.NewError(fiber.StatusForbidden, "You can only delete your own comment")
+		}
+
+		if time.Since(comment.CreatedAt) > commentEditWindow {
+			return fiber.NewError(fiber.StatusForbidden, "Comment can no longer be deleted")
+		}
+	}
+
+	if err := s.repository.DeleteThread(comment.Id); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (s *commentService) findCommentResponse(id, viewerId string) (*res.CommentResponse, error) {
+	comment, err := s.repository.FindResponseById(id)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	comments := []res.CommentResponse{*comment}
+
+	if err := s.repository.AttachReactions(comments, viewerId); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return &comments[0], nil
+}
+
+func (s *commentService) findBlogComment(blogId, commentId string) (*entity.Comment, error) {
+	comment, err := s.repository.FindById(commentId)
+
+	if err != nil || comment.BlogId != blogId {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Comment not found")
+	}
+
+	return comment, nil
+}
+
+// findThreads paginates top level comments and nests all of their replies
+// underneath them with a fixed number of queries.
+func (s *commentService) findThreads(blogId string, page, limit int, viewerId string) ([]res.CommentResponse, int64, error) {
+	roots, total, err := s.repository.FindRootsPagination(blogId, page, limit, viewerId)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rootIds := make([]string, 0, len(roots))
+
+	for _, root := range roots {
+		rootIds = append(rootIds, root.Id)
+	}
+
+	replies, err := s.repository.FindDescendants(rootIds, viewerId)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := s.repository.AttachReactions(roots, viewerId); err != nil {
+		return nil, 0, err
+	}
+
+	if err := s.repository.AttachReactions(replies, viewerId); err != nil {
+		return nil, 0, err
+	}
+
+	children := map[string][]res.CommentResponse{}
+
+	for _, reply := range replies {
+		children[*reply.ParentId] = append(children[*reply.ParentId], reply)
+	}
+
+	for i := range roots {
+		roots[i].Replies = nestReplies(roots[i].Id, children)
+	}
+
+	return roots, total, nil
+}
+
+func nestReplies(parentId string, children map[string][]res.CommentResponse) []res.CommentResponse {
+	replies := children[parentId]
+
+	for i := range replies {
+		replies[i].Replies = nestReplies(replies[i].Id, children)
+	}
+
+	return replies
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ContributorService interface {
+	FindContributors(blogId string) ([]res.ContributorResponse, error)
+	SetContributor(blogId, userId string, payload *req.ContributorDto, user model.JwtPayload) ([]res.ContributorResponse, error)
+	RemoveContributor(blogId, userId string, user model.JwtPayload) error
+	FindContributions(userId string, pagination *req.ContributionPaginationRequest, viewerId string) (*model.MetaPagination, []res.FindBlogResponse, error)
+}
+
+type contributorService struct {
+	repository     *repository.ContributorRepository
+	blogRepository *repository.BlogRepository
+	userRepository *repository.UserRepository
+}
+
+func NewContributorService(
+	repository *repository.ContributorRepository,
+	blogRepository *repository.BlogRepository,
+	userRepository *repository.UserRepository,
+) ContributorService {
+	return &contributorService{
+		repository:     repository,
+		blogRepository: blogRepository,
+		userRepository: userRepository,
+	}
+}
+
+func (s *contributorService) FindContributors(blogId string) ([]res.ContributorResponse, error) {
+	if _, err := s.blogRepository.FindEntityById(blogId); err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	contributors, err := s.repository.FindByBlog(blogId)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return contributors, nil
+}
+
+// SetContributor adds a co-author or editor, or changes their role. Only the
+// owner and admins manage contributors, co-authors and editors only edit.
+func (s *contributorService) SetContributor(blogId, userId string, payload *req.ContributorDto, user model.JwtPayload) ([]res.ContributorResponse, error) {
+	blog, err := s.blogRepository.FindEntityById(blogId)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	if blog.UserId != user.Id && user.Role != enum.ROLE_ADMIN {
+		return nil, fiber.NewError(fiber.StatusForbidden, "Only the author can manage contributors")
+	}
+
+	if userId == blog.UserId {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "The author of the blog can not be a contributor")
+	}
+
+	if _, err := s.userRepository.FindById(userId); err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	contributor := entity.BlogContributor{BlogId: blog.Id, UserId: userId, Role: payload.Role}
+
+	if err := s.repository.Save(&contributor); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return s.FindContributors(blog.Id)
+}
+
+// RemoveContributor lets the owner and admins remove anyone, and contributors
+// step down themselves.
+func (s *contributorService) RemoveContributor(blogId, userId string, user model.JwtPayload) error {
+	blog, err := s.blogRepository.FindEntityById(blogId)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	if blog.UserId != user.Id && user.Role != enum.ROLE_ADMIN && userId != user.Id {
+		return fiber.NewError(fiber.StatusForbidden, "Only the author can manage contributors")
+	}
+
+	removed, err := s.repository.Delete(blog.Id, userId)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if !removed {
+		return fiber.NewError(fiber.StatusNotFound, "Contributor not found")
+	}
+
+	return nil
+}
+
+func (s *contributorService) FindContributions(userId string, pagination *req.ContributionPaginationRequest, viewerId string) (*model.MetaPagination, []res.FindBlogResponse, error) {
+	if _, err := s.userRepository.FindById(userId); err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	blogs, total, err := s.blogRepository.FindContributed(userId, pagination.Role, pagination.Page, pagination.Limit, viewerId)
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	totalPage := (total + int64(pagination.Limit) - 1) / int64(pagination.Limit)
+
+	meta := &model.MetaPagination{
+		Page:      pagination.Page,
+		Limit:     pagination.Limit,
+		TotalPage: int(totalPage),
+		TotalData: int(total),
+	}
+
+	return meta, blogs, nil
+}
@@ -0,0 +1,334 @@
+package service
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+	"learn/fiber/utils"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// FeedLinks are the absolute URLs a feed points to, SiteUrl is where blogs
+// are read and FeedUrl is the URL the feed itself was requested from.
+type FeedLinks struct {
+	Title   string
+	SiteUrl string
+	FeedUrl string
+}
+
+type FeedService interface {
+	Render(format enum.EFeedFormat, request *req.FeedRequest, links FeedLinks) (*res.Feed, error)
+}
+
+type feedService struct {
+	blogRepository *repository.BlogRepository
+}
+
+func NewFeedService(blogRepository *repository.BlogRepository) FeedService {
+	return &feedService{blogRepository: blogRepository}
+}
+
+func (s *feedService) Render(format enum.EFeedFormat, request *req.FeedRequest, links FeedLinks) (*res.Feed, error) {
+	items, err := s.blogRepository.FindFeed(request.Limit, request.Author, utils.Slugify(request.Tag))
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	feed := &res.Feed{
+		ETag:         feedETag(format, links.FeedUrl, items),
+		LastModified: feedLastModified(items),
+	}
+
+	switch format {
+	case enum.FEED_FORMAT_ATOM:
+		feed.ContentType = "application/atom+xml; charset=utf-8"
+		feed.Body, err = renderAtom(items, links, feed.LastModified)
+	case enum.FEED_FORMAT_JSON:
+		feed.ContentType = "application/feed+json; charset=utf-8"
+		feed.Body, err = renderJsonFeed(items, links)
+	default:
+		feed.ContentType = "application/rss+xml; charset=utf-8"
+		feed.Body, err = renderRss(items, links, feed.LastModified)
+	}
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return feed, nil
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	AtomNS  string     `xml:"xmlns:atom,attr"`
+	DcNS    string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string      `xml:"title"`
+	Link          string      `xml:"link"`
+	Description   string      `xml:"description"`
+	AtomLink      rssAtomLink `xml:"atom:link"`
+	LastBuildDate string      `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem   `xml:"item"`
+}
+
+type rssAtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Guid        rssGuid  `xml:"guid"`
+	Description string   `xml:"description"`
+	Creator     string   `xml:"dc:creator"`
+	PubDate     string   `xml:"pubDate"`
+	Categories  []string `xml:"category"`
+}
+
+type rssGuid struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+func renderRss(items []res.FeedItem, links FeedLinks, lastModified time.Time) ([]byte, error) {
+	feed := rssFeed{
+		Version: "2.0",
+		AtomNS:  "http://www.w3.org/2005/Atom",
+		DcNS:    "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:       links.Title,
+			Link:        links.SiteUrl,
+			Description: "Latest blogs from " + links.Title,
+			AtomLink:    rssAtomLink{Href: links.FeedUrl, Rel: "self", Type: "application/rss+xml"},
+			Items:       make([]rssItem, 0, len(items)),
+		},
+	}
+
+	if !lastModified.IsZero() {
+		feed.Channel.LastBuildDate = lastModified.Format(time.RFC1123Z)
+	}
+
+	for _, item := range items {
+		link := blogLink(links.SiteUrl, item.Id)
+
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       item.Title,
+			Link:        link,
+			Guid:        rssGuid{Value: link, IsPermaLink: true},
+			Description: item.Body,
+			Creator:     item.Author,
+			PubDate:     item.CreatedAt.Format(time.RFC1123Z),
+			Categories:  item.Tags,
+		})
+	}
+
+	return marshalXml(feed)
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	Id      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title      string         `xml:"title"`
+	Id         string         `xml:"id"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     atomAuthor     `xml:"author"`
+	Summary    string         `xml:"summary"`
+	Content    atomContent    `xml:"content"`
+	Categories []atomCategory `xml:"category"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+func renderAtom(items []res.FeedItem, links FeedLinks, lastModified time.Time) ([]byte, error) {
+	if lastModified.IsZero() {
+		lastModified = time.Now()
+	}
+
+	feed := atomFeed{
+		Title:   links.Title,
+		Id:      links.FeedUrl,
+		Updated: lastModified.Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: links.FeedUrl, Rel: "self", Type: "application/atom+xml"},
+			{Href: links.SiteUrl, Rel: "alternate"},
+		},
+		Entries: make([]atomEntry, 0, len(items)),
+	}
+
+	for _, item := range items {
+		link := blogLink(links.SiteUrl, item.Id)
+		categories := make([]atomCategory, 0, len(item.Tags))
+
+		for _, tag := range item.Tags {
+			categories = append(categories, atomCategory{Term: tag})
+		}
+
+		feed.Entries = append(feed.Entries, atomEntry{
+			Title:      item.Title,
+			Id:         link,
+			Link:       atomLink{Href: link, Rel: "alternate"},
+			Published:  item.CreatedAt.Format(time.RFC3339),
+			Updated:    item.UpdatedAt.Format(time.RFC3339),
+			Author:     atomAuthor{Name: item.Author},
+			Summary:    excerpt(item.Body, 280),
+			Content:    atomContent{Type: "text", Value: item.Body},
+			Categories: categories,
+		})
+	}
+
+	return marshalXml(feed)
+}
+
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageUrl string         `json:"home_page_url"`
+	FeedUrl     string         `json:"feed_url"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	Id            string           `json:"id"`
+	Url           string           `json:"url"`
+	Title         string           `json:"title"`
+	ContentText   string           `json:"content_text"`
+	Summary       string           `json:"summary"`
+	Image         string           `json:"image,omitempty"`
+	DatePublished string           `json:"date_published"`
+	DateModified  string           `json:"date_modified"`
+	Authors       []jsonFeedAuthor `json:"authors"`
+	Tags          []string         `json:"tags,omitempty"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+func renderJsonFeed(items []res.FeedItem, links FeedLinks) ([]byte, error) {
+	feed := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       links.Title,
+		HomePageUrl: links.SiteUrl,
+		FeedUrl:     links.FeedUrl,
+		Items:       make([]jsonFeedItem, 0, len(items)),
+	}
+
+	for _, item := range items {
+		link := blogLink(links.SiteUrl, item.Id)
+
+		feed.Items = append(feed.Items, jsonFeedItem{
+			Id:            link,
+			Url:           link,
+			Title:         item.Title,
+			ContentText:   item.Body,
+			Summary:       excerpt(item.Body, 280),
+			Image:         item.Image,
+			DatePublished: item.CreatedAt.Format(time.RFC3339),
+			DateModified:  item.UpdatedAt.Format(time.RFC3339),
+			Authors:       []jsonFeedAuthor{{Name: item.Author}},
+			Tags:          item.Tags,
+		})
+	}
+
+	return json.Marshal(feed)
+}
+
+func marshalXml(feed any) ([]byte, error) {
+	body, err := xml.MarshalIndent(feed, "", "  ")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
+
+func blogLink(siteUrl, id string) string {
+	return strings.TrimRight(siteUrl, "/") + "/blog/" + id
+}
+
+// feedETag changes whenever a blog in the feed is added, removed or edited,
+// and differs per format and query so caches never mix them up.
+func feedETag(format enum.EFeedFormat, feedUrl string, items []res.FeedItem) string {
+	hash := sha1.New()
+
+	fmt.Fprintf(hash, "%s|%s", format, feedUrl)
+
+	for _, item := range items {
+		fmt.Fprintf(hash, "|%s@%d", item.Id, item.UpdatedAt.UnixNano())
+	}
+
+	return `W/"` + hex.EncodeToString(hash.Sum(nil)) + `"`
+}
+
+func feedLastModified(items []res.FeedItem) time.Time {
+	var lastModified time.Time
+
+	for _, item := range items {
+		if item.UpdatedAt.After(lastModified) {
+			lastModified = item.UpdatedAt
+		}
+	}
+
+	return lastModified
+}
+
+// excerpt collapses whitespace and cuts text to at most limit runes on a
+// word boundary.
+func excerpt(text string, limit int) string {
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+
+	if len(runes) <= limit {
+		return string(runes)
+	}
+
+	cut := string(runes[:limit])
+
+	if space := strings.LastIndex(cut, " "); space > 0 {
+		cut = cut[:space]
+	}
+
+	return cut + "…"
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"mime/multipart"
 	"path/filepath"
 	"time"
@@ -15,12 +17,15 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/gofiber/fiber/v2"
 )
 
 type FileService interface {
 	Upload(file *multipart.FileHeader) (*res.UploadFileResponse, error)
 	Serve(s3Key string) (*s3.GetObjectOutput, error)
+	Exists(s3Key string) (bool, error)
+	Put(s3Key string, body io.Reader, contentType string) error
 }
 
 type fileService struct {
@@ -105,3 +110,43 @@ func (f *fileService) Serve(s3Key string) (*s3.GetObjectOutput, error) {
 
 	return resp, nil
 }
+
+func (f *fileService) Exists(s3Key string) (bool, error) {
+	_, err := f.client.HeadObject(context.TODO(), &s3.HeadObjectInput{
+		Bucket: aws.String(f.bucket),
+		Key:    aws.String(s3Key),
+	})
+
+	var notFound *types.NotFound
+
+	if errors.As(err, &notFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fiber.NewError(fiber.StatusServiceUnavailable, err.Error())
+	}
+
+	return true, nil
+}
+
+// Put stores body under a key chosen by the caller, used by imports that
+// keep the keys of the files they bring along.
+func (f *fileService) Put(s3Key string, body io.Reader, contentType string) error {
+	uploader := manager.NewUploader(f.client)
+
+	_, err := uploader.Upload(context.TODO(), &s3.PutObjectInput{
+		Bucket:             aws.String(f.bucket),
+		Key:                aws.String(s3Key),
+		Body:               body,
+		ACL:                "public-read",
+		ContentType:        aws.String(contentType),
+		ContentDisposition: aws.String("inline"),
+	})
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/repository"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type FollowService interface {
+	Follow(followerId, followingId string) (*entity.UserResponse, error)
+	Unfollow(followerId, followingId string) (*entity.UserResponse, error)
+	FindFollowers(userId string, pagination *model.PaginationRequest) (*model.MetaPagination, []entity.UserResponse, error)
+	FindFollowing(userId string, pagination *model.PaginationRequest) (*model.MetaPagination, []entity.UserResponse, error)
+}
+
+type followService struct {
+	repository     *repository.FollowRepository
+	userRepository *repository.UserRepository
+}
+
+func NewFollowService(repository *repository.FollowRepository, userRepository *repository.UserRepository) FollowService {
+	return &followService{repository: repository, userRepository: userRepository}
+}
+
+// Follow returns the followed user so clients get the updated follower
+// count back.
+func (s *followService) Follow(followerId, followingId string) (*entity.UserResponse, error) {
+	if followerId == followingId {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "You can not follow yourself")
+	}
+
+	user, err := s.userRepository.FindById(followingId)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	follow := entity.Follow{FollowerId: followerId, FollowingId: user.Id}
+
+	if err := s.repository.Create(&follow); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return s.findUserResponse(*user)
+}
+
+func (s *followService) Unfollow(followerId, followingId string) (*entity.UserResponse, error) {
+	user, err := s.userRepository.FindById(followingId)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	if err := s.repository.Delete(followerId, user.Id); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return s.findUserResponse(*user)
+}
+
+func (s *followService) FindFollowers(userId string, pagination *model.PaginationRequest) (*model.MetaPagination, []entity.UserResponse, error) {
+	return s.findUsers(userId, pagination, s.repository.FindFollowers)
+}
+
+func (s *followService) FindFollowing(userId string, pagination *model.PaginationRequest) (*model.MetaPagination, []entity.UserResponse, error) {
+	return s.findUsers(userId, pagination, s.repository.FindFollowing)
+}
+
+func (s *followService) findUsers(
+	userId string,
+	pagination *model.PaginationRequest,
+	find func(userId string, page, limit int) ([]entity.User, int64, error),
+) (*model.MetaPagination, []entity.UserResponse, error) {
+	if _, err := s.userRepository.FindById(userId); err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	users, total, err := find(userId, pagination.Page, pagination.Limit)
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	userResponses, err := transformUserResponses(s.repository, users)
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	totalPage := (total + int64(pagination.Limit) - 1) / int64(pagination.Limit)
+
+	meta := &model.MetaPagination{
+		Page:      pagination.Page,
+		Limit:     pagination.Limit,
+		TotalPage: int(totalPage),
+		TotalData: int(total),
+	}
+
+	return meta, userResponses, nil
+}
+
+func (s *followService) findUserResponse(user entity.User) (*entity.UserResponse, error) {
+	userResponses, err := transformUserResponses(s.repository, []entity.User{user})
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return &userResponses[0], nil
+}
@@ -0,0 +1,230 @@
+package service
+
+import (
+	"fmt"
+	"learn/fiber/config"
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+	"learn/fiber/utils"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+// defaultAutoHideReports is used when MODERATION_AUTO_HIDE_REPORTS is not
+// set, content with this many pending reports is hidden until reviewed.
+const defaultAutoHideReports = 5
+
+type ModerationService interface {
+	ReportBlog(blogId string, payload *req.ReportDto, userId string) (*res.ReportResponse, error)
+	ReportComment(blogId, commentId string, payload *req.ReportDto, userId string) (*res.ReportResponse, error)
+	FindQueue(params *req.ModerationQueueRequest) (*model.MetaPagination, []res.ModerationQueueItem, error)
+	Hide(targetType, targetId string, user model.JwtPayload) error
+	Restore(targetType, targetId string, user model.JwtPayload) error
+	Dismiss(targetType, targetId string, user model.JwtPayload) error
+}
+
+type moderationService struct {
+	repository        *repository.ReportRepository
+	blogRepository    *repository.BlogRepository
+	commentRepository *repository.CommentRepository
+}
+
+func NewModerationService(
+	repository *repository.ReportRepository,
+	blogRepository *repository.BlogRepository,
+	commentRepository *repository.CommentRepository,
+) ModerationService {
+	return &moderationService{
+		repository:        repository,
+		blogRepository:    blogRepository,
+		commentRepository: commentRepository,
+	}
+}
+
+func (s *moderationService) ReportBlog(blogId string, payload *req.ReportDto, userId string) (*res.ReportResponse, error) {
+	blog, err := s.blogRepository.FindEntityById(blogId)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	if blog.UserId == userId {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "You can not report your own blog")
+	}
+
+	return s.report(enum.REPORT_TARGET_BLOG, blog.Id, payload, userId)
+}
+
+func (s *moderationService) ReportComment(blogId, commentId string, payload *req.ReportDto, userId string) (*res.ReportResponse, error) {
+	comment, err := s.commentRepository.FindById(commentId)
+
+	if err != nil || comment.BlogId != blogId {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Comment not found")
+	}
+
+	if comment.UserId == userId {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "You can not report your own comment")
+	}
+
+	return s.report(enum.REPORT_TARGET_COMMENT, comment.Id, payload, userId)
+}
+
+func (s *moderationService) FindQueue(params *req.ModerationQueueRequest) (*model.MetaPagination, []res.ModerationQueueItem, error) {
+	items, total, err := s.repository.FindQueue(params.TargetType, params.Page, params.Limit)
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	totalPage := (total + int64(params.Limit) - 1) / int64(params.Limit)
+
+	meta := &model.MetaPagination{
+		Page:      params.Page,
+		Limit:     params.Limit,
+		TotalPage: int(totalPage),
+		TotalData: int(total),
+	}
+
+	return meta, items, nil
+}
+
+// Hide takes the content down and marks its reports as actioned.
+func (s *moderationService) Hide(targetType, targetId string, user model.JwtPayload) error {
+	hidden := true
+
+	return s.resolve(targetType, targetId, &hidden, enum.REPORT_STATUS_ACTIONED, user)
+}
+
+// Restore makes hidden content visible again, its pending reports are
+// dismissed since a moderator found the content acceptable.
+func (s *moderationService) Restore(targetType, targetId string, user model.JwtPayload) error {
+	hidden := false
+
+	return s.resolve(targetType, targetId, &hidden, enum.REPORT_STATUS_DISMISSED, user)
+}
+
+// Dismiss closes the pending reports and leaves the content as it is.
+func (s *moderationService) Dismiss(targetType, targetId string, user model.JwtPayload) error {
+	return s.resolve(targetType, targetId, nil, enum.REPORT_STATUS_DISMISSED, user)
+}
+
+func (s *moderationService) resolve(targetType, targetId string, hidden *bool, status enum.EReportStatus, user model.JwtPayload) error {
+	target := enum.EReportTarget(targetType)
+
+	if err := s.checkTarget(target, targetId); err != nil {
+		return err
+	}
+
+	if err := s.repository.Resolve(target, targetId, hidden, status, user.Id); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (s *moderationService) checkTarget(target enum.EReportTarget, targetId string) error {
+	var err error
+
+	switch target {
+	case enum.REPORT_TARGET_BLOG:
+		_, err = s.blogRepository.FindEntityById(targetId)
+	case enum.REPORT_TARGET_COMMENT:
+		_, err = s.commentRepository.FindById(targetId)
+	default:
+		return fiber.NewError(fiber.StatusBadRequest, "Target type must be one of blog, comment")
+	}
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusNotFound, fmt.Sprintf("%s %s not found", target, targetId))
+	}
+
+	return nil
+}
+
+// report stores the report and hides the content once it reaches the
+// auto-hide threshold. A failing auto-hide does not fail the report.
+func (s *moderationService) report(target enum.EReportTarget, targetId string, payload *req.ReportDto, userId string) (*res.ReportResponse, error) {
+	exists, err := s.repository.ExistsByReporter(userId, target, targetId)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if exists {
+		return nil, fiber.NewError(fiber.StatusConflict, fmt.Sprintf("You already reported this %s", target))
+	}
+
+	report := entity.Report{
+		ReporterId: userId,
+		TargetType: target,
+		TargetId:   targetId,
+		Reason:     payload.Reason,
+		Details:    payload.Details,
+		Status:     enum.REPORT_STATUS_PENDING,
+	}
+
+	if err := s.repository.Create(&report); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if threshold := autoHideReports(); threshold > 0 {
+		pending, err := s.repository.CountPending(target, targetId)
+
+		if err == nil && pending >= int64(threshold) {
+			err = s.repository.SetHidden(target, targetId, true)
+		}
+
+		if err != nil {
+			log.Errorf("Failed to auto-hide %s %s: %v", target, targetId, err)
+		}
+	}
+
+	return &res.ReportResponse{
+		Id:         report.Id,
+		TargetType: string(report.TargetType),
+		TargetId:   report.TargetId,
+		Reason:     string(report.Reason),
+		Details:    report.Details,
+		Status:     string(report.Status),
+		CreatedAt:  report.CreatedAt,
+	}, nil
+}
+
+// autoHideReports reads MODERATION_AUTO_HIDE_REPORTS, 0 turns auto-hiding
+// off and anything unparsable falls back to the default.
+func autoHideReports() int {
+	value := config.MODERATION_AUTO_HIDE_REPORTS.GetValue()
+
+	if value == "" {
+		return defaultAutoHideReports
+	}
+
+	threshold, err := strconv.Atoi(value)
+
+	if err != nil || threshold < 0 {
+		return defaultAutoHideReports
+	}
+
+	return threshold
+}
+
+// rejectBannedWords fails with a field error when text contains a word from
+// MODERATION_BANNED_WORDS.
+func rejectBannedWords(field, text string) error {
+	word, found := utils.FindBannedWord(text)
+
+	if !found {
+		return nil
+	}
+
+	return utils.NewValidationError(fiber.StatusBadRequest, model.FieldError{
+		Field:   field,
+		Message: fmt.Sprintf("contains the banned word %q", word),
+	})
+}
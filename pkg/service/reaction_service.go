@@ -0,0 +1,84 @@
+package service
+
+import (
+	"errors"
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type ReactionService interface {
+	ToggleBlogReaction(blogId string, payload *req.ReactionDto, userId string) (*res.ReactionSummary, error)
+	ToggleCommentReaction(blogId, commentId string, payload *req.ReactionDto, userId string) (*res.ReactionSummary, error)
+}
+
+type reactionService struct {
+	repository        *repository.ReactionRepository
+	blogRepository    *repository.BlogRepository
+	commentRepository *repository.CommentRepository
+}
+
+func NewReactionService(
+	repository *repository.ReactionRepository,
+	blogRepository *repository.BlogRepository,
+	commentRepository *repository.CommentRepository,
+) ReactionService {
+	return &reactionService{
+		repository:        repository,
+		blogRepository:    blogRepository,
+		commentRepository: commentRepository,
+	}
+}
+
+func (s *reactionService) ToggleBlogReaction(blogId string, payload *req.ReactionDto, userId string) (*res.ReactionSummary, error) {
+	if blog, err := s.blogRepository.FindEntityById(blogId); err != nil || (blog.HiddenAt != nil && blog.UserId != userId) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Blog not found")
+	}
+
+	return s.toggle(enum.REACTION_TARGET_BLOG, blogId, payload.Type, userId)
+}
+
+func (s *reactionService) ToggleCommentReaction(blogId, commentId string, payload *req.ReactionDto, userId string) (*res.ReactionSummary, error) {
+	comment, err := s.commentRepository.FindById(commentId)
+
+	if err != nil || comment.BlogId != blogId {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Comment not found")
+	}
+
+	return s.toggle(enum.REACTION_TARGET_COMMENT, commentId, payload.Type, userId)
+}
+
+// toggle removes the reaction when the user sends the same type again and
+// otherwise creates it or switches it to the new type.
+func (s *reactionService) toggle(targetType enum.EReactionTarget, targetId string, reactionType enum.EReaction, userId string) (*res.ReactionSummary, error) {
+	existing, err := s.repository.FindByUserAndTarget(userId, targetType, targetId)
+
+	switch {
+	case err == nil && existing.Type == reactionType:
+		err = s.repository.Delete(existing.Id)
+	case err == nil || errors.Is(err, gorm.ErrRecordNotFound):
+		err = s.repository.Upsert(&entity.Reaction{
+			UserId:     userId,
+			TargetType: targetType,
+			TargetId:   targetId,
+			Type:       reactionType,
+		})
+	}
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	summary, err := s.repository.FindSummary(targetType, targetId, userId)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,301 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+	"learn/fiber/utils"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type SeedService interface {
+	Seed(fixtures *req.SeedRequest) (*res.SeedResponse, error)
+}
+
+type seedService struct {
+	userRepository     *repository.UserRepository
+	blogRepository     *repository.BlogRepository
+	tagRepository      *repository.TagRepository
+	categoryRepository *repository.CategoryRepository
+}
+
+func NewSeedService(
+	userRepository *repository.UserRepository,
+	blogRepository *repository.BlogRepository,
+	tagRepository *repository.TagRepository,
+	categoryRepository *repository.CategoryRepository,
+) SeedService {
+	return &seedService{
+		userRepository:     userRepository,
+		blogRepository:     blogRepository,
+		tagRepository:      tagRepository,
+		categoryRepository: categoryRepository,
+	}
+}
+
+// Seed creates the users and then the blogs of a fixture. Users are matched
+// by email and blogs by author and title, so seeding the same fixture again
+// only adds what is new.
+func (s *seedService) Seed(fixtures *req.SeedRequest) (*res.SeedResponse, error) {
+	if err := utils.ValidateStruct(utils.NewValidator(), fixtures); err != nil {
+		return nil, err
+	}
+
+	seeder := &seeder{
+		service:    s,
+		report:     &res.SeedResponse{Items: []res.SeedItem{}},
+		users:      map[string]string{},
+		tags:       map[string]*entity.Tag{},
+		categories: map[string]*entity.Category{},
+	}
+
+	for i := range fixtures.Users {
+		seeder.seedUser(&fixtures.Users[i])
+	}
+
+	for i := range fixtures.Blogs {
+		seeder.seedBlog(&fixtures.Blogs[i])
+	}
+
+	return seeder.report, nil
+}
+
+// seeder caches the records found or created during one seed, users by
+// email and tags and categories by slug.
+type seeder struct {
+	service    *seedService
+	report     *res.SeedResponse
+	users      map[string]string
+	tags       map[string]*entity.Tag
+	categories map[string]*entity.Category
+}
+
+func (s *seeder) skip(kind, source, reason string, count *res.ImportCount) {
+	count.Skipped++
+	s.report.Items = append(s.report.Items, res.SeedItem{
+		Kind:   kind,
+		Source: source,
+		Status: enum.IMPORT_STATUS_SKIPPED,
+		Reason: reason,
+	})
+}
+
+func (s *seeder) fail(kind, source string, err error, count *res.ImportCount) {
+	count.Failed++
+	s.report.Items = append(s.report.Items, res.SeedItem{
+		Kind:   kind,
+		Source: source,
+		Status: enum.IMPORT_STATUS_FAILED,
+		Reason: err.Error(),
+	})
+}
+
+func (s *seeder) seedUser(fixture *req.SeedUserDto) {
+	count := &s.report.Users
+	email := strings.ToLower(strings.TrimSpace(fixture.Email))
+
+	if user, err := s.service.userRepository.FindByEmail(email); err == nil {
+		s.users[email] = user.Id
+		s.skip("user", email, "a user with this email already exists", count)
+		return
+	}
+
+	if !utils.ValidatePassword(fixture.Password) {
+		s.fail("user", email, errors.New(utils.PasswordRules), count)
+		return
+	}
+
+	password, err := hashedPassword(fixture.Password)
+
+	if err != nil {
+		s.fail("user", email, err, count)
+		return
+	}
+
+	role := fixture.Role
+
+	if role == "" {
+		role = enum.ROLE_USER
+	}
+
+	user := entity.User{
+		Email:    email,
+		Username: strings.TrimSpace(fixture.Username),
+		Role:     role,
+		Password: password,
+	}
+
+	if err := s.service.userRepository.Create(&user); err != nil {
+		s.fail("user", email, err, count)
+		return
+	}
+
+	s.users[email] = user.Id
+	count.Created++
+}
+
+func (s *seeder) seedBlog(fixture *req.SeedBlogDto) {
+	count := &s.report.Blogs
+	title := strings.TrimSpace(fixture.Title)
+	author := strings.ToLower(strings.TrimSpace(fixture.Author))
+	userId, ok := s.users[author]
+
+	if !ok {
+		user, err := s.service.userRepository.FindByEmail(author)
+
+		if err != nil {
+			s.fail("blog", title, fmt.Errorf("author %s not found", author), count)
+			return
+		}
+
+		userId = user.Id
+		s.users[author] = userId
+	}
+
+	exists, err := s.service.blogRepository.ExistsByTitle(userId, title, "")
+
+	if err != nil {
+		s.fail("blog", title, err, count)
+		return
+	}
+
+	if exists {
+		s.skip("blog", title, "the author already has a blog with this title", count)
+		return
+	}
+
+	format := fixture.Format
+
+	if format == "" {
+		format = enum.BLOG_FORMAT_MARKDOWN
+	}
+
+	blog := entity.Blog{
+		Title:      title,
+		Body:       fixture.Body,
+		Format:     format,
+		Image:      fixture.Image,
+		UserId:     userId,
+		Language:   fixture.Language,
+		Tags:       []entity.Tag{},
+		Categories: []entity.Category{},
+	}
+
+	seenTags := map[string]bool{}
+
+	for _, name := range fixture.Tags {
+		slug := utils.Slugify(name)
+
+		if slug == "" || seenTags[slug] {
+			continue
+		}
+
+		seenTags[slug] = true
+		tag, err := s.findOrCreateTag(strings.TrimSpace(name), slug)
+
+		if err != nil {
+			s.fail("blog", title, err, count)
+			return
+		}
+
+		blog.Tags = append(blog.Tags, *tag)
+	}
+
+	seenCategories := map[string]bool{}
+
+	for _, name := range fixture.Categories {
+		slug := utils.Slugify(name)
+
+		if slug == "" || seenCategories[slug] {
+			continue
+		}
+
+		seenCategories[slug] = true
+		category, err := s.findOrCreateCategory(strings.TrimSpace(name), slug)
+
+		if err != nil {
+			s.fail("blog", title, err, count)
+			return
+		}
+
+		blog.Categories = append(blog.Categories, *category)
+	}
+
+	if err := s.service.blogRepository.Create(&blog); err != nil {
+		s.fail("blog", title, err, count)
+		return
+	}
+
+	count.Created++
+}
+
+// findOrCreateTag counts existing tags as skipped without listing them, the
+// same goes for categories.
+func (s *seeder) findOrCreateTag(name, slug string) (*entity.Tag, error) {
+	if tag, ok := s.tags[slug]; ok {
+		return tag, nil
+	}
+
+	count := &s.report.Tags
+	tag, err := s.service.tagRepository.FindBySlug(slug)
+
+	switch {
+	case err == nil:
+		count.Skipped++
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		tag = &entity.Tag{Name: name, Slug: slug}
+
+		if err := s.service.tagRepository.Create(tag); err != nil {
+			count.Failed++
+			return nil, fmt.Errorf("tag %s: %w", name, err)
+		}
+
+		count.Created++
+	default:
+		count.Failed++
+		return nil, fmt.Errorf("tag %s: %w", name, err)
+	}
+
+	s.tags[slug] = tag
+
+	return tag, nil
+}
+
+func (s *seeder) findOrCreateCategory(name, slug string) (*entity.Category, error) {
+	if category, ok := s.categories[slug]; ok {
+		return category, nil
+	}
+
+	count := &s.report.Categories
+	existing, err := s.service.categoryRepository.FindBySlugs([]string{slug})
+
+	if err != nil {
+		count.Failed++
+		return nil, fmt.Errorf("category %s: %w", name, err)
+	}
+
+	if len(existing) > 0 {
+		count.Skipped++
+		s.categories[slug] = &existing[0]
+
+		return &existing[0], nil
+	}
+
+	category := &entity.Category{Name: name, Slug: slug}
+
+	if err := s.service.categoryRepository.Create(category); err != nil {
+		count.Failed++
+		return nil, fmt.Errorf("category %s: %w", name, err)
+	}
+
+	count.Created++
+	s.categories[slug] = category
+
+	return category, nil
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"fmt"
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+	"learn/fiber/utils"
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type SeriesService interface {
+	CreateSeries(payload *req.SeriesDto, userId string) (*res.SeriesDetailResponse, error)
+	FindById(id, viewerId string) (*res.SeriesDetailResponse, error)
+	FindByUser(userId string) ([]res.SeriesResponse, error)
+	UpdateSeries(id string, payload *req.SeriesDto, user model.JwtPayload) (*res.SeriesDetailResponse, error)
+	DeleteSeries(id string, user model.JwtPayload) error
+}
+
+type seriesService struct {
+	repository     *repository.SeriesRepository
+	blogRepository *repository.BlogRepository
+}
+
+func NewSeriesService(repository *repository.SeriesRepository, blogRepository *repository.BlogRepository) SeriesService {
+	return &seriesService{repository: repository, blogRepository: blogRepository}
+}
+
+func (s *seriesService) CreateSeries(payload *req.SeriesDto, userId string) (*res.SeriesDetailResponse, error) {
+	blogIds := payload.BlogIds
+
+	if blogIds == nil {
+		blogIds = []string{}
+	}
+
+	if err := s.checkBlogs(blogIds, userId, ""); err != nil {
+		return nil, err
+	}
+
+	series := entity.Series{
+		UserId:      userId,
+		Title:       strings.TrimSpace(payload.Title),
+		Description: strings.TrimSpace(payload.Description),
+	}
+
+	if err := s.repository.Create(&series, blogIds); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return s.FindById(series.Id, userId)
+}
+
+func (s *seriesService) FindById(id, viewerId string) (*res.SeriesDetailResponse, error) {
+	series, err := s.repository.FindResponseById(id)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	posts, err := s.blogRepository.FindBySeries(series.Id, viewerId)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return &res.SeriesDetailResponse{SeriesResponse: *series, Posts: posts}, nil
+}
+
+func (s *seriesService) FindByUser(userId string) ([]res.SeriesResponse, error) {
+	series, err := s.repository.FindByUser(userId)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return series, nil
+}
+
+// UpdateSeries replaces the title, description and, when blogIds is sent,
+// the posts and their order.
+func (s *seriesService) UpdateSeries(id string, payload *req.SeriesDto, user model.JwtPayload) (*res.SeriesDetailResponse, error) {
+	series, err := s.findEditable(id, user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkBlogs(payload.BlogIds, series.UserId, series.Id); err != nil {
+		return nil, err
+	}
+
+	series.Title = strings.TrimSpace(payload.Title)
+	series.Description = strings.TrimSpace(payload.Description)
+
+	if err := s.repository.Update(series, payload.BlogIds); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return s.FindById(series.Id, user.Id)
+}
+
+// DeleteSeries removes the series only, its posts stay published.
+func (s *seriesService) DeleteSeries(id string, user model.JwtPayload) error {
+	series, err := s.findEditable(id, user)
+
+	if err != nil {
+		return err
+	}
+
+	if err := s.repository.Delete(series.Id); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (s *seriesService) findEditable(id string, user model.JwtPayload) (*entity.Series, error) {
+	series, err := s.repository.FindById(id)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	if series.UserId != user.Id && user.Role != enum.ROLE_ADMIN {
+		return nil, fiber.NewError(fiber.StatusForbidden, "You don't have permission to edit this series")
+	}
+
+	return series, nil
+}
+
+// checkBlogs requires every blog to be listed once, to belong to the author
+// of the series and to not be part of another series.
+func (s *seriesService) checkBlogs(blogIds []string, userId, seriesId string) error {
+	for i, blogId := range blogIds {
+		if slices.Contains(blogIds[:i], blogId) {
+			return utils.NewValidationError(fiber.StatusBadRequest, model.FieldError{
+				Field:   "blogIds",
+				Message: fmt.Sprintf("lists %s more than once", blogId),
+			})
+		}
+	}
+
+	owned, err := s.blogRepository.FindIdsByUser(userId, blogIds)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	for _, blogId := range blogIds {
+		if !slices.Contains(owned, blogId) {
+			return utils.NewValidationError(fiber.StatusBadRequest, model.FieldError{
+				Field:   "blogIds",
+				Message: fmt.Sprintf("%s is not a blog of the series author", blogId),
+			})
+		}
+	}
+
+	taken, err := s.repository.FindTaken(blogIds, seriesId)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if len(taken) > 0 {
+		return utils.NewValidationError(fiber.StatusConflict, model.FieldError{
+			Field:   "blogIds",
+			Message: fmt.Sprintf("%s is already part of another series", taken[0].BlogId),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+	"learn/fiber/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type TagService interface {
+	CreateTag(payload *req.TagDto) (*res.TagResponse, error)
+	FindAll() ([]res.TagWithCountResponse, error)
+	UpdateTagById(id string, payload *req.TagDto) (*res.TagResponse, error)
+	DeleteTagById(id string) error
+}
+
+type tagService struct {
+	repository *repository.TagRepository
+}
+
+func NewTagService(repository *repository.TagRepository) TagService {
+	return &tagService{repository: repository}
+}
+
+func (t *tagService) CreateTag(payload *req.TagDto) (*res.TagResponse, error) {
+	slug := utils.Slugify(payload.Name)
+
+	if slug == "" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Tag name must contain at least one letter or number")
+	}
+
+	tag := entity.Tag{
+		Name: payload.Name,
+		Slug: slug,
+	}
+
+	if err := t.repository.Create(&tag); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	tagResponse := transformTagResponse(tag)
+
+	return &tagResponse, nil
+}
+
+func (t *tagService) FindAll() ([]res.TagWithCountResponse, error) {
+	tags, err := t.repository.FindAllWithCount()
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return tags, nil
+}
+
+func (t *tagService) UpdateTagById(id string, payload *req.TagDto) (*res.TagResponse, error) {
+	tag, err := t.repository.FindById(id)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	slug := utils.Slugify(payload.Name)
+
+	if slug == "" {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Tag name must contain at least one letter or number")
+	}
+
+	tag.Name = payload.Name
+	tag.Slug = slug
+
+	if err := t.repository.Update(tag); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	tagResponse := transformTagResponse(*tag)
+
+	return &tagResponse, nil
+}
+
+func (t *tagService) DeleteTagById(id string) error {
+	if _, err := t.repository.FindById(id); err != nil {
+		return fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	if err := t.repository.Delete(id); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return nil
+}
+
+func transformTagResponse(tag entity.Tag) res.TagResponse {
+	return res.TagResponse{
+		Id:   tag.Id,
+		Name: tag.Name,
+		Slug: tag.Slug,
+	}
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"learn/fiber/config"
+	"learn/fiber/pkg/model"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+	"learn/fiber/utils"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+)
+
+const (
+	// defaultTrashRetentionDays is used when TRASH_RETENTION_DAYS is not set.
+	defaultTrashRetentionDays = 30
+	trashPurgeInterval        = time.Hour
+)
+
+type TrashService interface {
+	FindUsers(pagination *model.PaginationRequest) (*model.MetaPagination, []res.TrashUserResponse, error)
+	RestoreUser(id string) error
+	PurgeUser(id string) error
+	FindBlogs(pagination *model.PaginationRequest) (*model.MetaPagination, []res.TrashBlogResponse, error)
+	RestoreBlog(id string) error
+	PurgeBlog(id string) error
+	Close()
+}
+
+type trashService struct {
+	repository     *repository.TrashRepository
+	blogRepository *repository.BlogRepository
+	stop           chan struct{}
+	done           chan struct{}
+	closeOnce      sync.Once
+}
+
+// NewTrashService starts the goroutine that purges users and blogs once they
+// have been in the trash longer than TRASH_RETENTION_DAYS, Close stops it.
+func NewTrashService(repository *repository.TrashRepository, blogRepository *repository.BlogRepository) TrashService {
+	s := &trashService{
+		repository:     repository,
+		blogRepository: blogRepository,
+		stop:           make(chan struct{}),
+		done:           make(chan struct{}),
+	}
+
+	go s.run()
+
+	return s
+}
+
+func (s *trashService) FindUsers(pagination *model.PaginationRequest) (*model.MetaPagination, []res.TrashUserResponse, error) {
+	users, total, err := s.repository.FindUsers(pagination.Page, pagination.Limit)
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	for i := range users {
+		users[i].PurgeAt = purgeAt(users[i].DeletedAt)
+	}
+
+	return trashMeta(pagination, total), users, nil
+}
+
+// RestoreUser brings back a user with the blogs deleted along with it.
+func (s *trashService) RestoreUser(id string) error {
+	if _, err := s.repository.FindUser(id); err != nil {
+		return fiber.NewError(fiber.StatusNotFound, "User not found in trash")
+	}
+
+	if err := s.repository.RestoreUser(id); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (s *trashService) PurgeUser(id string) error {
+	if _, err := s.repository.FindUser(id); err != nil {
+		return fiber.NewError(fiber.StatusNotFound, "User not found in trash")
+	}
+
+	if err := s.repository.PurgeUser(id); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (s *trashService) FindBlogs(pagination *model.PaginationRequest) (*model.MetaPagination, []res.TrashBlogResponse, error) {
+	blogs, total, err := s.repository.FindBlogs(pagination.Page, pagination.Limit)
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	for i := range blogs {
+		blogs[i].PurgeAt = purgeAt(blogs[i].DeletedAt)
+	}
+
+	return trashMeta(pagination, total), blogs, nil
+}
+
+// RestoreBlog brings back a blog whose owner is not deleted, as long as the
+// owner has not written another blog with the same title in the meantime.
+func (s *trashService) RestoreBlog(id string) error {
+	blog, err := s.repository.FindBlog(id)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusNotFound, "Blog not found in trash")
+	}
+
+	if blog.OwnerDeleted {
+		return fiber.NewError(fiber.StatusConflict, "The owner of this blog is deleted, restore the owner instead")
+	}
+
+	exists, err := s.blogRepository.ExistsByTitle(blog.UserId, blog.Title, blog.Id)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if exists {
+		return utils.NewValidationError(fiber.StatusConflict, model.FieldError{
+			Field:   "title",
+			Message: "is already used by another blog of the owner",
+		})
+	}
+
+	if err := s.repository.RestoreBlog(blog.Id); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (s *trashService) PurgeBlog(id string) error {
+	if _, err := s.repository.FindBlog(id); err != nil {
+		return fiber.NewError(fiber.StatusNotFound, "Blog not found in trash")
+	}
+
+	if err := s.repository.PurgeBlog(id); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return nil
+}
+
+func (s *trashService) Close() {
+	s.closeOnce.Do(func() {
+		close(s.stop)
+		<-s.done
+	})
+}
+
+func (s *trashService) run() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+
+	purge := func(now time.Time) {
+		days := trashRetentionDays()
+
+		if days == 0 {
+			return
+		}
+
+		users, blogs, err := s.repository.PurgeExpired(now.AddDate(0, 0, -days))
+
+		if err != nil {
+			log.Errorf("Failed to purge trash: %v", err)
+		}
+
+		if users > 0 || blogs > 0 {
+			log.Infof("Purged %d users and %d blogs from the trash", users, blogs)
+		}
+	}
+
+	purge(time.Now())
+
+	for {
+		select {
+		case now := <-ticker.C:
+			purge(now)
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// trashRetentionDays reads TRASH_RETENTION_DAYS, 0 keeps deleted rows
+// forever.
+func trashRetentionDays() int {
+	value := config.TRASH_RETENTION_DAYS.GetValue()
+
+	if value == "" {
+		return defaultTrashRetentionDays
+	}
+
+	days, err := strconv.Atoi(value)
+
+	if err != nil || days < 0 {
+		return defaultTrashRetentionDays
+	}
+
+	return days
+}
+
+func purgeAt(deletedAt time.Time) *time.Time {
+	days := trashRetentionDays()
+
+	if days == 0 {
+		return nil
+	}
+
+	at := deletedAt.AddDate(0, 0, days)
+
+	return &at
+}
+
+func trashMeta(pagination *model.PaginationRequest, total int64) *model.MetaPagination {
+	totalPage := (total + int64(pagination.Limit) - 1) / int64(pagination.Limit)
+
+	return &model.MetaPagination{
+		Page:      pagination.Page,
+		Limit:     pagination.Limit,
+		TotalPage: int(totalPage),
+		TotalData: int(total),
+	}
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"learn/fiber/pkg/enum"
 	"learn/fiber/pkg/model"
 	"learn/fiber/pkg/model/entity"
 	"learn/fiber/pkg/repository"
@@ -16,18 +17,23 @@ type UserService interface {
 	RefreshToken(refreshToken string) (*model.RefreshTokenResponse, error)
 	FindAll() ([]entity.UserResponse, error)
 	FindAllPaginated(pagination *model.PaginationRequest) (*model.MetaPagination, []entity.UserResponse, error)
+	FindAllCursor(pagination *model.CursorPaginationRequest) (*model.MetaCursor, []entity.UserResponse, error)
 	FindById(id string) (*entity.UserResponse, error)
-	UpdateUserById(id string, payload *entity.UserUpdateRequest) (*entity.UserResponse, error)
+	UpdateUserById(id string, payload *entity.UserUpdateRequest, currentUser model.JwtPayload) (*entity.UserResponse, error)
 	DeleteUserById(id string) error
+	ResetPassword(email, password string) error
+	SetPasswordById(id string, payload *entity.UserPasswordRequest) (*entity.UserResponse, error)
 }
 
 type userService struct {
-	repository *repository.UserRepository
+	repository       *repository.UserRepository
+	followRepository *repository.FollowRepository
 }
 
-func NewUserService(repository *repository.UserRepository) UserService {
+func NewUserService(repository *repository.UserRepository, followRepository *repository.FollowRepository) UserService {
 	return &userService{
-		repository: repository,
+		repository:       repository,
+		followRepository: followRepository,
 	}
 }
 
@@ -46,7 +52,7 @@ func (u *userService) RegisterUser(payload *entity.UserRegisterRequest) (*entity
 		Email:    payload.Email,
 		Username: payload.Username,
 		Password: passwordHashed,
-		Role:     payload.Role,
+		Role:     enum.ROLE_USER,
 	}
 
 	if err := u.repository.Create(&user); err != nil {
@@ -69,6 +75,10 @@ func (u *userService) LoginUser(payload *entity.UserLoginRequest) (*model.JwtRes
 		return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid email or password!")
 	}
 
+	if user.PasswordResetRequired {
+		return nil, fiber.NewError(fiber.StatusForbidden, "Your password has to be reset by an admin before you can log in")
+	}
+
 	jwtPayload := model.JwtPayload{
 		Id:   user.Id,
 		Role: user.Role,
@@ -111,28 +121,26 @@ func (u *userService) FindAll() ([]entity.UserResponse, error) {
 		return nil, err
 	}
 
-	var userResponses []entity.UserResponse = []entity.UserResponse{}
-
-	for _, user := range users {
-		userResponse := transformUserResponse(user)
-		userResponses = append(userResponses, userResponse)
-	}
-
-	return userResponses, nil
+	return transformUserResponses(u.followRepository, users)
 }
 
 func (u *userService) FindAllPaginated(pagination *model.PaginationRequest) (*model.MetaPagination, []entity.UserResponse, error) {
-	users, totalData, err := u.repository.FindAllPaginated(pagination.Page, pagination.Limit, pagination.Search)
+	users, totalData, err := u.repository.FindAllPaginated(
+		pagination.Page,
+		pagination.Limit,
+		pagination.Search,
+		pagination.Filters,
+		pagination.Sort,
+	)
 
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
-	userResponses := []entity.UserResponse{}
+	userResponses, err := transformUserResponses(u.followRepository, users)
 
-	for _, user := range users {
-		userResponse := transformUserResponse(user)
-		userResponses = append(userResponses, userResponse)
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
 	totalPage := (totalData + int64(pagination.Limit) - 1) / int64(pagination.Limit)
@@ -147,6 +155,41 @@ func (u *userService) FindAllPaginated(pagination *model.PaginationRequest) (*mo
 	return meta, userResponses, nil
 }
 
+func (u *userService) FindAllCursor(pagination *model.CursorPaginationRequest) (*model.MetaCursor, []entity.UserResponse, error) {
+	cursor, err := decodeCursor(pagination.Cursor)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users, hasMore, err := u.repository.FindAllCursor(pagination.Limit, pagination.Search, cursor)
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	userResponses, err := transformUserResponses(u.followRepository, users)
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	var first, last *utils.Cursor
+
+	if len(users) > 0 {
+		first = &utils.Cursor{CreatedAt: users[0].CreatedAt, Id: users[0].Id}
+		last = &utils.Cursor{CreatedAt: users[len(users)-1].CreatedAt, Id: users[len(users)-1].Id}
+	}
+
+	meta, err := utils.NewMetaCursor(pagination.Limit, cursor, hasMore, first, last)
+
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	return meta, userResponses, nil
+}
+
 func (u *userService) FindById(id string) (*entity.UserResponse, error) {
 	user, err := u.repository.FindById(id)
 
@@ -154,29 +197,40 @@ func (u *userService) FindById(id string) (*entity.UserResponse, error) {
 		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
 	}
 
-	userResponse := transformUserResponse(*user)
+	userResponses, err := transformUserResponses(u.followRepository, []entity.User{*user})
 
-	return &userResponse, nil
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return &userResponses[0], nil
 }
 
-func (u *userService) UpdateUserById(id string, payload *entity.UserUpdateRequest) (*entity.UserResponse, error) {
+// UpdateUserById keeps the role when none is sent, only an admin can change
+// it.
+func (u *userService) UpdateUserById(id string, payload *entity.UserUpdateRequest, currentUser model.JwtPayload) (*entity.UserResponse, error) {
 	user, err := u.repository.FindById(id)
 
 	if err != nil {
 		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
 	}
 
+	if payload.Role != "" && payload.Role != user.Role {
+		if currentUser.Role != enum.ROLE_ADMIN {
+			return nil, fiber.NewError(fiber.StatusForbidden, "Only an admin can change the role of a user")
+		}
+
+		user.Role = payload.Role
+	}
+
 	user.Email = payload.Email
 	user.Username = payload.Username
-	user.Role = payload.Role
 
 	if err := u.repository.Update(user); err != nil {
 		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
-	userResponse := transformUserResponse(*user)
-
-	return &userResponse, nil
+	return u.FindById(user.Id)
 }
 
 func (u *userService) DeleteUserById(id string) error {
@@ -187,19 +241,64 @@ func (u *userService) DeleteUserById(id string) error {
 	return nil
 }
 
-func transformUserResponse(user entity.User) entity.UserResponse {
-	userResponse := entity.UserResponse{
-		Id:        user.Id,
-		Email:     user.Email,
-		Username:  user.Username,
-		Role:      user.Role,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+// ResetPassword sets a new password and clears PasswordResetRequired, so
+// accounts created by an import can log in.
+func (u *userService) ResetPassword(email, password string) error {
+	user, err := u.repository.FindByEmail(email)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusNotFound, "User not found")
 	}
 
-	if user.DeletedAt.Valid {
-		deleted := user.DeletedAt.Time
-		userResponse.DeletedAt = &deleted
+	return u.setPassword(user, password)
+}
+
+// SetPasswordById is the admin side of ResetPassword, it unlocks imported
+// accounts without access to the command line.
+func (u *userService) SetPasswordById(id string, payload *entity.UserPasswordRequest) (*entity.UserResponse, error) {
+	if payload.Password != payload.ConfirmPassword {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Password and Confirm Password do not match")
+	}
+
+	user, err := u.repository.FindById(id)
+
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, err.Error())
+	}
+
+	if err := u.setPassword(user, payload.Password); err != nil {
+		return nil, err
+	}
+
+	return u.FindById(user.Id)
+}
+
+func (u *userService) setPassword(user *entity.User, password string) error {
+	passwordHashed, err := hashedPassword(password)
+
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	user.Password = passwordHashed
+	user.PasswordResetRequired = false
+
+	if err := u.repository.Update(user); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	return nil
+}
+
+func transformUserResponse(user entity.User) entity.UserResponse {
+	userResponse := entity.UserResponse{
+		Id:                    user.Id,
+		Email:                 user.Email,
+		Username:              user.Username,
+		Role:                  user.Role,
+		PasswordResetRequired: user.PasswordResetRequired,
+		CreatedAt:             user.CreatedAt,
+		UpdatedAt:             user.UpdatedAt,
 	}
 
 	return userResponse
@@ -215,3 +314,30 @@ func checkPasswordHash(password, hash string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
 }
+
+// transformUserResponses transforms users and fills in their follow counts
+// with a single query.
+func transformUserResponses(followRepository *repository.FollowRepository, users []entity.User) ([]entity.UserResponse, error) {
+	ids := make([]string, 0, len(users))
+
+	for _, user := range users {
+		ids = append(ids, user.Id)
+	}
+
+	counts, err := followRepository.CountByUserIds(ids)
+
+	if err != nil {
+		return nil, err
+	}
+
+	userResponses := make([]entity.UserResponse, 0, len(users))
+
+	for _, user := range users {
+		userResponse := transformUserResponse(user)
+		userResponse.FollowerCount = counts[user.Id].FollowerCount
+		userResponse.FollowingCount = counts[user.Id].FollowingCount
+		userResponses = append(userResponses, userResponse)
+	}
+
+	return userResponses, nil
+}
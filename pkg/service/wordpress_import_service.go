@@ -0,0 +1,862 @@
+package service
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+	"learn/fiber/utils"
+	"mime"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+const (
+	wxrDateLayout   = "2006-01-02 15:04:05"
+	wxrMaxMediaSize = 20 * 1024 * 1024
+)
+
+// wxrUploadPattern matches links to files in the uploads folder of a
+// WordPress site, including the resized copies WordPress generates.
+var wxrUploadPattern = regexp.MustCompile(`(?:https?:)?//[^\s"'<>()]+/wp-content/uploads/[^\s"'<>()?#]+`)
+
+// sharedAddressSpace is the carrier grade NAT range, it is not routed on the
+// internet but netip does not count it as private.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// The wxr types only declare what the importer uses. WordPress changes the
+// namespace URL of its own elements between export versions, so they are
+// matched by local name.
+type wxrAuthor struct {
+	Id          string `xml:"author_id"`
+	Login       string `xml:"author_login"`
+	Email       string `xml:"author_email"`
+	DisplayName string `xml:"author_display_name"`
+}
+
+type wxrCategory struct {
+	Slug        string `xml:"category_nicename"`
+	Name        string `xml:"cat_name"`
+	Description string `xml:"category_description"`
+}
+
+type wxrTag struct {
+	Slug string `xml:"tag_slug"`
+	Name string `xml:"tag_name"`
+}
+
+type wxrItem struct {
+	Title         string        `xml:"title"`
+	Creator       string        `xml:"creator"`
+	Content       string        `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	PostId        string        `xml:"post_id"`
+	PostDate      string        `xml:"post_date_gmt"`
+	PostModified  string        `xml:"post_modified_gmt"`
+	PostName      string        `xml:"post_name"`
+	Status        string        `xml:"status"`
+	PostType      string        `xml:"post_type"`
+	AttachmentUrl string        `xml:"attachment_url"`
+	Terms         []wxrItemTerm `xml:"category"`
+	Meta          []wxrPostMeta `xml:"postmeta"`
+	Comments      []wxrComment  `xml:"comment"`
+}
+
+type wxrItemTerm struct {
+	Domain string `xml:"domain,attr"`
+	Slug   string `xml:"nicename,attr"`
+	Name   string `xml:",chardata"`
+}
+
+type wxrPostMeta struct {
+	Key   string `xml:"meta_key"`
+	Value string `xml:"meta_value"`
+}
+
+type wxrComment struct {
+	Id          string `xml:"comment_id"`
+	AuthorEmail string `xml:"comment_author_email"`
+	Date        string `xml:"comment_date_gmt"`
+	Content     string `xml:"comment_content"`
+	Approved    string `xml:"comment_approved"`
+	Type        string `xml:"comment_type"`
+	Parent      string `xml:"comment_parent"`
+	UserId      string `xml:"comment_user_id"`
+}
+
+type WordPressImportService interface {
+	Import(content io.Reader, options *req.WordPressImportRequest) (*res.WordPressImportResponse, error)
+}
+
+type wordPressImportService struct {
+	userRepository     *repository.UserRepository
+	blogRepository     *repository.BlogRepository
+	tagRepository      *repository.TagRepository
+	categoryRepository *repository.CategoryRepository
+	commentRepository  *repository.CommentRepository
+	fileService        FileService
+	client             *http.Client
+}
+
+func NewWordPressImportService(
+	userRepository *repository.UserRepository,
+	blogRepository *repository.BlogRepository,
+	tagRepository *repository.TagRepository,
+	categoryRepository *repository.CategoryRepository,
+	commentRepository *repository.CommentRepository,
+	fileService FileService,
+) WordPressImportService {
+	return &wordPressImportService{
+		userRepository:     userRepository,
+		blogRepository:     blogRepository,
+		tagRepository:      tagRepository,
+		categoryRepository: categoryRepository,
+		commentRepository:  commentRepository,
+		fileService:        fileService,
+		client:             newMediaClient(),
+	}
+}
+
+// Import reads a WordPress export (WXR) one element at a time so exports of
+// any size are imported without holding them in memory. Content that already
+// exists is skipped, so an interrupted import can be run again.
+func (s *wordPressImportService) Import(content io.Reader, options *req.WordPressImportRequest) (*res.WordPressImportResponse, error) {
+	importer := &wordPressImporter{
+		service:     s,
+		validator:   utils.NewValidator(),
+		skipMedia:   options.SkipMedia,
+		report:      &res.WordPressImportResponse{Items: []res.WordPressImportItem{}},
+		authors:     map[string]string{},
+		authorIds:   map[string]string{},
+		categories:  map[string]*entity.Category{},
+		tags:        map[string]*entity.Tag{},
+		attachments: map[string]string{},
+		media:       map[string]string{},
+		thumbnails:  map[string]string{},
+	}
+
+	decoder := xml.NewDecoder(content)
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+	channel := false
+
+	for {
+		token, err := decoder.Token()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Invalid WordPress export: %v", err))
+		}
+
+		start, ok := token.(xml.StartElement)
+
+		if !ok {
+			continue
+		}
+
+		// WordPress elements are namespaced, the RSS ones are not.
+		wordPress := start.Name.Space != ""
+
+		switch {
+		case start.Name.Local == "channel":
+			channel = true
+		case start.Name.Local == "author" && wordPress:
+			var author wxrAuthor
+
+			if err := decoder.DecodeElement(&author, &start); err != nil {
+				return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Invalid WordPress export: %v", err))
+			}
+
+			importer.importAuthor(&author)
+		case start.Name.Local == "category" && wordPress:
+			var category wxrCategory
+
+			if err := decoder.DecodeElement(&category, &start); err != nil {
+				return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Invalid WordPress export: %v", err))
+			}
+
+			importer.findOrCreateCategory(category.Slug, category.Name, category.Description)
+		case start.Name.Local == "tag" && wordPress:
+			var tag wxrTag
+
+			if err := decoder.DecodeElement(&tag, &start); err != nil {
+				return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Invalid WordPress export: %v", err))
+			}
+
+			importer.findOrCreateTag(tag.Slug, tag.Name)
+		case start.Name.Local == "item":
+			var item wxrItem
+
+			if err := decoder.DecodeElement(&item, &start); err != nil {
+				return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Invalid WordPress export: %v", err))
+			}
+
+			importer.importItem(&item)
+		}
+	}
+
+	if !channel {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Invalid WordPress export: no channel element found")
+	}
+
+	importer.attachThumbnails()
+
+	return importer.report, nil
+}
+
+// wordPressImporter maps WordPress ids to the records created for them
+// during one import.
+type wordPressImporter struct {
+	service   *wordPressImportService
+	validator *validator.Validate
+	skipMedia bool
+	report    *res.WordPressImportResponse
+
+	// authors is keyed by login, authorIds by WordPress user id.
+	authors     map[string]string
+	authorIds   map[string]string
+	categories  map[string]*entity.Category
+	tags        map[string]*entity.Tag
+	attachments map[string]string
+
+	// media maps WordPress file URLs to their copies, thumbnails maps blogs
+	// to featured images that were not read yet.
+	media      map[string]string
+	thumbnails map[string]string
+}
+
+func (i *wordPressImporter) skip(kind, source, reason string, count *res.ImportCount) {
+	count.Skipped++
+	i.report.Items = append(i.report.Items, res.WordPressImportItem{
+		Kind:   kind,
+		Source: source,
+		Status: enum.IMPORT_STATUS_SKIPPED,
+		Reason: reason,
+	})
+}
+
+func (i *wordPressImporter) fail(kind, source string, err error, count *res.ImportCount) {
+	count.Failed++
+	i.report.Items = append(i.report.Items, res.WordPressImportItem{
+		Kind:   kind,
+		Source: source,
+		Status: enum.IMPORT_STATUS_FAILED,
+		Reason: err.Error(),
+	})
+}
+
+// importAuthor creates a user with a random password that has to be reset,
+// an author whose email is already registered is mapped to that user.
+func (i *wordPressImporter) importAuthor(author *wxrAuthor) {
+	count := &i.report.Users
+	email := strings.ToLower(strings.TrimSpace(author.Email))
+
+	if email == "" {
+		i.skip("user", author.Login, "author has no email", count)
+		return
+	}
+
+	if user, err := i.service.userRepository.FindByEmail(email); err == nil {
+		i.mapAuthor(author, user.Id)
+		i.skip("user", author.Login, "a user with this email already exists, their posts are assigned to it", count)
+		return
+	}
+
+	password, err := randomPassword()
+
+	if err == nil {
+		password, err = hashedPassword(password)
+	}
+
+	if err != nil {
+		i.fail("user", author.Login, err, count)
+		return
+	}
+
+	username := strings.TrimSpace(author.DisplayName)
+
+	if username == "" {
+		username = author.Login
+	}
+
+	user := entity.User{
+		Email:                 email,
+		Username:              username,
+		Role:                  enum.ROLE_USER,
+		Password:              password,
+		PasswordResetRequired: true,
+	}
+
+	if err := i.service.userRepository.Create(&user); err != nil {
+		i.fail("user", author.Login, err, count)
+		return
+	}
+
+	i.mapAuthor(author, user.Id)
+	count.Created++
+}
+
+func (i *wordPressImporter) mapAuthor(author *wxrAuthor, userId string) {
+	i.authors[author.Login] = userId
+
+	if author.Id != "" {
+		i.authorIds[author.Id] = userId
+	}
+}
+
+func (i *wordPressImporter) findOrCreateCategory(slug, name, description string) *entity.Category {
+	name = strings.TrimSpace(html.UnescapeString(name))
+
+	if slug = utils.Slugify(slug); slug == "" {
+		slug = utils.Slugify(name)
+	}
+
+	if category, ok := i.categories[slug]; ok {
+		return category
+	}
+
+	count := &i.report.Categories
+
+	if slug == "" || name == "" {
+		i.skip("category", slug, "category has no name", count)
+		return nil
+	}
+
+	existing, err := i.service.categoryRepository.FindBySlugs([]string{slug})
+
+	if err != nil {
+		i.fail("category", slug, err, count)
+		return nil
+	}
+
+	if len(existing) > 0 {
+		i.categories[slug] = &existing[0]
+		i.skip("category", slug, "category already exists", count)
+
+		return &existing[0]
+	}
+
+	category := &entity.Category{Name: name, Slug: slug, Description: description}
+
+	if err := i.service.categoryRepository.Create(category); err != nil {
+		i.fail("category", slug, err, count)
+		return nil
+	}
+
+	i.categories[slug] = category
+	count.Created++
+
+	return category
+}
+
+func (i *wordPressImporter) findOrCreateTag(slug, name string) *entity.Tag {
+	name = strings.TrimSpace(html.UnescapeString(name))
+
+	if slug = utils.Slugify(slug); slug == "" {
+		slug = utils.Slugify(name)
+	}
+
+	if tag, ok := i.tags[slug]; ok {
+		return tag
+	}
+
+	count := &i.report.Tags
+
+	if slug == "" || name == "" {
+		i.skip("tag", slug, "tag has no name", count)
+		return nil
+	}
+
+	tag, err := i.service.tagRepository.FindBySlug(slug)
+
+	switch {
+	case err == nil:
+		i.skip("tag", slug, "tag already exists", count)
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		tag = &entity.Tag{Name: name, Slug: slug}
+
+		if err := i.service.tagRepository.Create(tag); err != nil {
+			i.fail("tag", slug, err, count)
+			return nil
+		}
+
+		count.Created++
+	default:
+		i.fail("tag", slug, err, count)
+		return nil
+	}
+
+	i.tags[slug] = tag
+
+	return tag
+}
+
+func (i *wordPressImporter) importItem(item *wxrItem) {
+	switch item.PostType {
+	case "attachment":
+		i.importAttachment(item)
+	case "post":
+		i.importPost(item)
+	default:
+		i.skip("blog", item.PostName, fmt.Sprintf("post type %s is not supported", item.PostType), &i.report.Blogs)
+	}
+}
+
+// importAttachment uploads the file right away, posts usually come after the
+// media they use.
+func (i *wordPressImporter) importAttachment(item *wxrItem) {
+	if item.AttachmentUrl == "" {
+		i.skip("media", item.PostId, "attachment has no URL", &i.report.Media)
+		return
+	}
+
+	i.attachments[item.PostId] = item.AttachmentUrl
+
+	if !i.skipMedia {
+		i.uploadMedia(item.AttachmentUrl)
+	}
+}
+
+func (i *wordPressImporter) importPost(item *wxrItem) {
+	count := &i.report.Blogs
+	source := item.PostName
+	title := strings.TrimSpace(item.Title)
+
+	if source == "" {
+		source = item.PostId
+	}
+
+	if item.Status != "publish" {
+		i.skip("blog", source, fmt.Sprintf("post status %s is not imported", item.Status), count)
+		return
+	}
+
+	userId, ok := i.authors[item.Creator]
+
+	if !ok {
+		i.fail("blog", source, fmt.Errorf("author %s was not imported", item.Creator), count)
+		return
+	}
+
+	exists, err := i.service.blogRepository.ExistsByTitle(userId, title, "")
+
+	if err != nil {
+		i.fail("blog", source, err, count)
+		return
+	}
+
+	if exists {
+		i.skip("blog", source, "the author already has a blog with this title", count)
+		i.report.Comments.Skipped += len(item.Comments)
+
+		return
+	}
+
+	blog := entity.Blog{
+		Title:      title,
+		Body:       i.rewriteMedia(item.Content),
+		Format:     enum.BLOG_FORMAT_HTML,
+		UserId:     userId,
+		Tags:       []entity.Tag{},
+		Categories: []entity.Category{},
+	}
+
+	blog.CreatedAt = parseWxrDate(item.PostDate)
+	blog.UpdatedAt = parseWxrDate(item.PostModified)
+
+	payload := req.CreateBlogDto{Title: blog.Title, Body: blog.Body, Format: blog.Format}
+
+	for _, term := range item.Terms {
+		switch term.Domain {
+		case "category":
+			if category := i.findOrCreateCategory(term.Slug, term.Name, ""); category != nil {
+				blog.Categories = append(blog.Categories, *category)
+				payload.CategoryIds = append(payload.CategoryIds, category.Id)
+			}
+		case "post_tag":
+			if tag := i.findOrCreateTag(term.Slug, term.Name); tag != nil {
+				blog.Tags = append(blog.Tags, *tag)
+				payload.Tags = append(payload.Tags, tag.Name)
+			}
+		}
+	}
+
+	if err := i.validateBlog(&payload); err != nil {
+		i.fail("blog", source, err, count)
+		i.report.Comments.Skipped += len(item.Comments)
+
+		return
+	}
+
+	thumbnailId := ""
+
+	for _, meta := range item.Meta {
+		if meta.Key == "_thumbnail_id" {
+			thumbnailId = meta.Value
+		}
+	}
+
+	attachment, found := i.attachments[thumbnailId]
+
+	if found {
+		blog.Image = i.imageUrl(attachment)
+	}
+
+	if err := i.service.blogRepository.Create(&blog); err != nil {
+		i.fail("blog", source, err, count)
+		return
+	}
+
+	if thumbnailId != "" && !found {
+		i.thumbnails[blog.Id] = thumbnailId
+	}
+
+	count.Created++
+	i.importComments(&blog, item.Comments)
+}
+
+// validateBlog applies the rules of CreateBlog, a post the API would refuse
+// is reported as failed instead of being created.
+func (i *wordPressImporter) validateBlog(payload *req.CreateBlogDto) error {
+	if err := utils.ValidateStruct(i.validator, payload); err != nil {
+		return err
+	}
+
+	if err := rejectBannedWords("title", payload.Title); err != nil {
+		return err
+	}
+
+	return rejectBannedWords("body", payload.Body)
+}
+
+// importComments creates the approved comments of a post oldest first, so
+// every reply finds its parent. Guests have no account here, their comments
+// are only kept when their email belongs to a user.
+func (i *wordPressImporter) importComments(blog *entity.Blog, comments []wxrComment) {
+	count := &i.report.Comments
+	ids := map[string]string{}
+
+	slices.SortFunc(comments, func(a, b wxrComment) int {
+		x, _ := strconv.Atoi(a.Id)
+		y, _ := strconv.Atoi(b.Id)
+
+		return x - y
+	})
+
+	for _, comment := range comments {
+		source := blog.Id + "#" + comment.Id
+
+		if comment.Approved != "1" || (comment.Type != "" && comment.Type != "comment") {
+			i.skip("comment", source, "comment is not approved or is a ping", count)
+			continue
+		}
+
+		userId := i.authorIds[comment.UserId]
+
+		if userId == "" && comment.AuthorEmail != "" {
+			if user, err := i.service.userRepository.FindByEmail(strings.ToLower(comment.AuthorEmail)); err == nil {
+				userId = user.Id
+			}
+		}
+
+		if userId == "" {
+			i.skip("comment", source, "guest comment without a matching user", count)
+			continue
+		}
+
+		body := strings.TrimSpace(comment.Content)
+
+		if body == "" {
+			i.skip("comment", source, "comment is empty", count)
+			continue
+		}
+
+		if err := i.validateComment(body); err != nil {
+			i.fail("comment", source, err, count)
+			continue
+		}
+
+		created := entity.Comment{
+			BlogId: blog.Id,
+			UserId: userId,
+			Body:   body,
+		}
+
+		created.CreatedAt = parseWxrDate(comment.Date)
+		created.UpdatedAt = created.CreatedAt
+
+		if parentId, ok := ids[comment.Parent]; ok {
+			created.ParentId = &parentId
+		}
+
+		if err := i.service.commentRepository.Create(&created); err != nil {
+			i.fail("comment", source, err, count)
+			continue
+		}
+
+		ids[comment.Id] = created.Id
+		count.Created++
+	}
+}
+
+// validateComment applies the rules of CreateComment.
+func (i *wordPressImporter) validateComment(body string) error {
+	if err := utils.ValidateStruct(i.validator, &req.CreateCommentDto{Body: body}); err != nil {
+		return err
+	}
+
+	return rejectBannedWords("body", body)
+}
+
+// attachThumbnails sets the image of blogs whose featured image came later
+// in the export than the blog itself.
+func (i *wordPressImporter) attachThumbnails() {
+	for blogId, thumbnailId := range i.thumbnails {
+		attachment, ok := i.attachments[thumbnailId]
+
+		if !ok {
+			continue
+		}
+
+		blog, err := i.service.blogRepository.FindEntityById(blogId)
+
+		if err != nil {
+			i.fail("blog", blogId, err, &i.report.Blogs)
+			continue
+		}
+
+		if blog.Image = i.imageUrl(attachment); blog.Image == "" {
+			continue
+		}
+
+		if err := i.service.blogRepository.Update(blog, blog.UserId); err != nil {
+			i.fail("blog", blogId, err, &i.report.Blogs)
+		}
+	}
+}
+
+// rewriteMedia replaces links to WordPress uploads with links to the copies
+// uploaded through the FileService, links that fail to copy are kept.
+func (i *wordPressImporter) rewriteMedia(body string) string {
+	if i.skipMedia {
+		return body
+	}
+
+	return wxrUploadPattern.ReplaceAllStringFunc(body, func(match string) string {
+		if uploaded, ok := i.uploadMedia(match); ok {
+			return uploaded
+		}
+
+		return match
+	})
+}
+
+// imageUrl is the copy of a featured image, or no image when media is
+// skipped or copying failed since the column only accepts files of this
+// server.
+func (i *wordPressImporter) imageUrl(attachment string) string {
+	if i.skipMedia {
+		return ""
+	}
+
+	uploaded, _ := i.uploadMedia(attachment)
+
+	return uploaded
+}
+
+// uploadMedia copies a file under a key derived from its URL, so importing
+// the same export twice stores every file once.
+func (i *wordPressImporter) uploadMedia(fileUrl string) (string, bool) {
+	if uploaded, ok := i.media[fileUrl]; ok {
+		return uploaded, uploaded != ""
+	}
+
+	count := &i.report.Media
+	serveUrl := mediaServeUrl()
+
+	if serveUrl == "" {
+		i.media[fileUrl] = ""
+		i.skip("media", fileUrl, "S3_SERVE_URL is not configured", count)
+
+		return "", false
+	}
+
+	key := mediaKey(fileUrl)
+	exists, err := i.service.fileService.Exists(key)
+
+	if err == nil && !exists {
+		err = i.service.download(fileUrl, key)
+	}
+
+	if err != nil {
+		i.media[fileUrl] = ""
+		i.fail("media", fileUrl, err, count)
+
+		return "", false
+	}
+
+	i.media[fileUrl] = serveUrl + key
+
+	if exists {
+		i.skip("media", fileUrl, "file was already imported", count)
+	} else {
+		count.Created++
+	}
+
+	return serveUrl + key, true
+}
+
+// newMediaClient downloads media from public addresses only. Links in an
+// export are not trusted, they could point the server at itself or at the
+// internal network. The address is checked after DNS resolution on every
+// connection, redirects included, and no proxy is used so it is the one
+// actually dialed.
+func newMediaClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: publicAddressOnly,
+	}
+
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+			MaxIdleConns:        10,
+		},
+	}
+}
+
+func publicAddressOnly(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+
+	if err != nil {
+		return err
+	}
+
+	ip, err := netip.ParseAddr(host)
+
+	if err != nil {
+		return err
+	}
+
+	ip = ip.Unmap()
+
+	// IsGlobalUnicast already excludes loopback, link-local, multicast and
+	// unspecified addresses.
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() || sharedAddressSpace.Contains(ip) {
+		return fmt.Errorf("address %s is not public", ip)
+	}
+
+	return nil
+}
+
+func (s *wordPressImportService) download(fileUrl, key string) error {
+	if strings.HasPrefix(fileUrl, "//") {
+		fileUrl = "https:" + fileUrl
+	}
+
+	if parsed, err := url.Parse(fileUrl); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return errors.New("only http and https links are downloaded")
+	}
+
+	response, err := s.client.Get(fileUrl)
+
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("download failed with status %d", response.StatusCode)
+	}
+
+	if response.ContentLength > wxrMaxMediaSize {
+		return fmt.Errorf("file is larger than %d MB", wxrMaxMediaSize/1024/1024)
+	}
+
+	// The declared length can be missing or wrong, reading stops one byte
+	// past the limit either way.
+	data, err := io.ReadAll(io.LimitReader(response.Body, wxrMaxMediaSize+1))
+
+	if err != nil {
+		return err
+	}
+
+	if len(data) > wxrMaxMediaSize {
+		return fmt.Errorf("file is larger than %d MB", wxrMaxMediaSize/1024/1024)
+	}
+
+	contentType := response.Header.Get(fiber.HeaderContentType)
+
+	if contentType == "" {
+		contentType = mime.TypeByExtension(path.Ext(key))
+	}
+
+	return s.fileService.Put(key, bytes.NewReader(data), contentType)
+}
+
+// mediaKey names a copied file after a hash of its URL, keeping a simple
+// extension so it matches the keys FileService.Upload generates.
+func mediaKey(fileUrl string) string {
+	sum := sha256.Sum256([]byte(strings.TrimPrefix(strings.TrimPrefix(fileUrl, "https:"), "http:")))
+	key := "wp-" + hex.EncodeToString(sum[:12])
+
+	if parsed, err := url.Parse(fileUrl); err == nil {
+		extension := strings.ToLower(path.Ext(parsed.Path))
+
+		if fileKeyPattern.MatchString("x" + extension) {
+			key += extension
+		}
+	}
+
+	return key
+}
+
+// parseWxrDate reads the GMT dates of an export, unpublished content has
+// 0000-00-00 00:00:00 which becomes the zero time so the database sets it.
+func parseWxrDate(value string) time.Time {
+	date, err := time.ParseInLocation(wxrDateLayout, strings.TrimSpace(value), time.UTC)
+
+	if err != nil {
+		return time.Time{}
+	}
+
+	return date
+}
+
+func randomPassword() (string, error) {
+	buffer := make([]byte, 24)
+
+	if _, err := rand.Read(buffer); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buffer), nil
+}
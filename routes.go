@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// closureSuffix matches the names Go gives to closures and method values,
+// such as RoleMiddleware.func1 or (*BlogHandler).FindBlogHandler-fm.
+var closureSuffix = regexp.MustCompile(`(\.func\d+)+(\.\d+)*$|-fm$`)
+
+// runRoutes prints every route with the middleware that runs before its
+// handler, group middleware first. The app is built from nil handlers, so no
+// database or S3 connection is needed.
+func runRoutes(args []string) error {
+	if len(args) > 0 {
+		return usageError("routes takes no arguments")
+	}
+
+	app := newApp(&handlers{})
+	all := app.GetRoutes()
+	endpoints := app.GetRoutes(true)
+
+	// Both lists follow the stack order, the routes missing from endpoints
+	// are the middleware registered with Use or Group.
+	var uses []fiber.Route
+	type row struct {
+		method, path, middleware, handler string
+	}
+	rows := []row{}
+	next := 0
+
+	for _, route := range all {
+		if next >= len(endpoints) || !sameRoute(route, endpoints[next]) {
+			uses = append(uses, route)
+			continue
+		}
+
+		next++
+
+		// Fiber registers a HEAD route for every GET.
+		if route.Method == fiber.MethodHead {
+			continue
+		}
+
+		middleware := []string{}
+
+		for _, use := range uses {
+			if use.Method == route.Method && use.Path != "/" && matchesPrefix(use.Path, route.Path) {
+				for _, h := range use.Handlers {
+					middleware = append(middleware, handlerName(h))
+				}
+			}
+		}
+
+		for _, h := range route.Handlers[:len(route.Handlers)-1] {
+			middleware = append(middleware, handlerName(h))
+		}
+
+		rows = append(rows, row{
+			method:     route.Method,
+			path:       route.Path,
+			middleware: strings.Join(middleware, ", "),
+			handler:    handlerName(route.Handlers[len(route.Handlers)-1]),
+		})
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].path < rows[j].path
+	})
+
+	// Middleware used on the whole app runs before every route, it is
+	// printed once instead of on every row.
+	global := []string{}
+
+	for _, use := range uses {
+		if use.Method == fiber.MethodGet && use.Path == "/" {
+			for _, h := range use.Handlers {
+				global = append(global, handlerName(h))
+			}
+		}
+	}
+
+	fmt.Printf("Every route runs: %s\n\n", strings.Join(global, ", "))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "METHOD\tPATH\tMIDDLEWARE\tHANDLER")
+
+	for _, r := range rows {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.method, r.path, r.middleware, r.handler)
+	}
+
+	return w.Flush()
+}
+
+func sameRoute(a, b fiber.Route) bool {
+	if a.Method != b.Method || a.Path != b.Path || len(a.Handlers) != len(b.Handlers) {
+		return false
+	}
+
+	for i := range a.Handlers {
+		if reflect.ValueOf(a.Handlers[i]).Pointer() != reflect.ValueOf(b.Handlers[i]).Pointer() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesPrefix reports whether a Use prefix covers a route path, a
+// parameter in the prefix matches any segment.
+func matchesPrefix(prefix, path string) bool {
+	prefixSegments := strings.Split(strings.Trim(prefix, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+
+	if prefixSegments[0] == "" {
+		return true
+	}
+
+	if len(prefixSegments) > len(pathSegments) {
+		return false
+	}
+
+	for i, segment := range prefixSegments {
+		if !strings.HasPrefix(segment, ":") && segment != pathSegments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// handlerName shortens the function name to its package and identifier,
+// learn/fiber/pkg/middleware.RoleMiddleware.func1 prints as
+// middleware.RoleMiddleware.
+func handlerName(h fiber.Handler) string {
+	name := runtime.FuncForPC(reflect.ValueOf(h).Pointer()).Name()
+	name = closureSuffix.ReplaceAllString(name, "")
+	name = name[strings.LastIndex(name, "/")+1:]
+
+	return strings.NewReplacer("(*", "", ")", "").Replace(name)
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"learn/fiber/config"
+	"learn/fiber/pkg/model/req"
+	"learn/fiber/pkg/model/res"
+	"learn/fiber/pkg/repository"
+	"learn/fiber/pkg/service"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+// runSeed loads a fixture of users and blogs, exiting with an error when any
+// of them failed.
+func runSeed(args []string) error {
+	if len(args) != 1 {
+		return usageError("seed takes the path of a fixture")
+	}
+
+	fixtures, err := readFixtures(args[0])
+
+	if err != nil {
+		return err
+	}
+
+	db := config.DBConfig()
+	seedService := service.NewSeedService(
+		repository.NewUserRepository(db),
+		repository.NewBlogRepository(db),
+		repository.NewTagRepository(db),
+		repository.NewCategoryRepository(db),
+	)
+
+	report, err := seedService.Seed(fixtures)
+
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KIND\tCREATED\tSKIPPED\tFAILED")
+
+	for _, row := range []struct {
+		kind  string
+		count res.ImportCount
+	}{
+		{"users", report.Users},
+		{"tags", report.Tags},
+		{"categories", report.Categories},
+		{"blogs", report.Blogs},
+	} {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", row.kind, row.count.Created, row.count.Skipped, row.count.Failed)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for _, item := range report.Items {
+		fmt.Printf("%s %s %s: %s\n", item.Status, item.Kind, item.Source, item.Reason)
+	}
+
+	if failed := report.Users.Failed + report.Blogs.Failed; failed > 0 {
+		return fmt.Errorf("%d users or blogs failed to seed", failed)
+	}
+
+	return nil
+}
+
+// readFixtures decodes a fixture by its extension and rejects unknown keys,
+// so a typo fails instead of seeding an empty field.
+func readFixtures(path string) (*req.SeedRequest, error) {
+	extension := strings.ToLower(filepath.Ext(path))
+
+	if extension != ".yaml" && extension != ".yml" && extension != ".json" {
+		return nil, fmt.Errorf("fixture %s must be a .yaml, .yml or .json file", path)
+	}
+
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var fixtures req.SeedRequest
+
+	switch extension {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&fixtures)
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(content))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&fixtures)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid fixture %s: %w", path, err)
+	}
+
+	return &fixtures, nil
+}
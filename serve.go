@@ -0,0 +1,241 @@
+package main
+
+import (
+	"fmt"
+	"learn/fiber/config"
+	"learn/fiber/pkg/err"
+	"learn/fiber/pkg/handler"
+	"learn/fiber/pkg/middleware"
+	"learn/fiber/pkg/repository"
+	"learn/fiber/pkg/router"
+	"learn/fiber/pkg/service"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/gofiber/fiber/v2/middleware/compress"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+	"github.com/gofiber/fiber/v2/middleware/logger"
+	"github.com/gofiber/fiber/v2/middleware/monitor"
+	"github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/gofiber/swagger"
+	"gorm.io/gorm"
+)
+
+type handlers struct {
+	user            *handler.UserHandler
+	blog            *handler.BlogHandler
+	file            *handler.FileHandler
+	tag             *handler.TagHandler
+	category        *handler.CategoryHandler
+	comment         *handler.CommentHandler
+	reaction        *handler.ReactionHandler
+	blogRevision    *handler.BlogRevisionHandler
+	feed            *handler.FeedHandler
+	follow          *handler.FollowHandler
+	bookmark        *handler.BookmarkHandler
+	blogView        *handler.BlogViewHandler
+	moderation      *handler.ModerationHandler
+	importer        *handler.ImportHandler
+	series          *handler.SeriesHandler
+	contributor     *handler.ContributorHandler
+	blogTranslation *handler.BlogTranslationHandler
+	trash           *handler.TrashHandler
+}
+
+// runServe migrates the database and serves the API until SIGINT or SIGTERM.
+func runServe(args []string) error {
+	if len(args) > 0 {
+		return usageError("serve takes no arguments")
+	}
+
+	port := config.PORT.GetValue()
+
+	if port == "" {
+		port = "3000"
+	}
+
+	db := config.DBConfig()
+
+	if err := migrateDatabase(db); err != nil {
+		return fmt.Errorf("migrate database: %w", err)
+	}
+
+	h, closeServices, err := newHandlers(db)
+
+	if err != nil {
+		return err
+	}
+
+	defer closeServices()
+
+	app := newApp(h)
+
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+		<-quit
+
+		if err := app.Shutdown(); err != nil {
+			log.Errorf("Failed to shut down server: %v", err)
+		}
+	}()
+
+	log.Infof("Server running on http://127.0.0.1:%s/api/v1 🚀", port)
+
+	if err := app.Listen(":" + port); err != nil {
+		return fmt.Errorf("listen on port %s: %w", port, err)
+	}
+
+	return nil
+}
+
+// newHandlers wires the repositories and services behind every handler, the
+// returned func stops the services working in the background.
+func newHandlers(db *gorm.DB) (*handlers, func(), error) {
+	// Init Repository
+	userRepository := repository.NewUserRepository(db)
+	blogRepository := repository.NewBlogRepository(db)
+	tagRepository := repository.NewTagRepository(db)
+	categoryRepository := repository.NewCategoryRepository(db)
+	commentRepository := repository.NewCommentRepository(db)
+	reactionRepository := repository.NewReactionRepository(db)
+	blogRevisionRepository := repository.NewBlogRevisionRepository(db)
+	followRepository := repository.NewFollowRepository(db)
+	bookmarkRepository := repository.NewBookmarkRepository(db)
+	blogViewRepository := repository.NewBlogViewRepository(db)
+	reportRepository := repository.NewReportRepository(db)
+	seriesRepository := repository.NewSeriesRepository(db)
+	contributorRepository := repository.NewContributorRepository(db)
+	blogTranslationRepository := repository.NewBlogTranslationRepository(db)
+	trashRepository := repository.NewTrashRepository(db)
+
+	// Init Service
+	fileService, err := service.NewFileService()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("create file service: %w", err)
+	}
+
+	userService := service.NewUserService(userRepository, followRepository)
+	blogService := service.NewBlogService(
+		blogRepository,
+		userRepository,
+		tagRepository,
+		categoryRepository,
+		fileService,
+		blogTranslationRepository,
+	)
+	tagService := service.NewTagService(tagRepository)
+	categoryService := service.NewCategoryService(categoryRepository)
+	commentService := service.NewCommentService(commentRepository, blogRepository)
+	reactionService := service.NewReactionService(reactionRepository, blogRepository, commentRepository)
+	blogRevisionService := service.NewBlogRevisionService(blogRevisionRepository, blogRepository, blogService)
+	feedService := service.NewFeedService(blogRepository)
+	followService := service.NewFollowService(followRepository, userRepository)
+	bookmarkService := service.NewBookmarkService(bookmarkRepository, blogRepository)
+	blogViewService := service.NewBlogViewService(blogViewRepository, blogRepository)
+	moderationService := service.NewModerationService(reportRepository, blogRepository, commentRepository)
+	seriesService := service.NewSeriesService(seriesRepository, blogRepository)
+	contributorService := service.NewContributorService(contributorRepository, blogRepository, userRepository)
+	blogTranslationService := service.NewBlogTranslationService(blogTranslationRepository, blogRepository)
+	trashService := service.NewTrashService(trashRepository, blogRepository)
+	wordPressImportService := service.NewWordPressImportService(
+		userRepository,
+		blogRepository,
+		tagRepository,
+		categoryRepository,
+		commentRepository,
+		fileService,
+	)
+
+	// Init Handler
+	h := &handlers{
+		user:            handler.NewUserHandler(userService),
+		blog:            handler.NewBlogHandler(blogService, blogViewService),
+		file:            handler.NewFileHandler(fileService),
+		tag:             handler.NewTagHandler(tagService),
+		category:        handler.NewCategoryHandler(categoryService),
+		comment:         handler.NewCommentHandler(commentService),
+		reaction:        handler.NewReactionHandler(reactionService),
+		blogRevision:    handler.NewBlogRevisionHandler(blogRevisionService),
+		feed:            handler.NewFeedHandler(feedService),
+		follow:          handler.NewFollowHandler(followService),
+		bookmark:        handler.NewBookmarkHandler(bookmarkService),
+		blogView:        handler.NewBlogViewHandler(blogViewService),
+		moderation:      handler.NewModerationHandler(moderationService),
+		importer:        handler.NewImportHandler(wordPressImportService),
+		series:          handler.NewSeriesHandler(seriesService),
+		contributor:     handler.NewContributorHandler(contributorService),
+		blogTranslation: handler.NewBlogTranslationHandler(blogTranslationService),
+		trash:           handler.NewTrashHandler(trashService),
+	}
+
+	return h, func() {
+		// Views are buffered in memory, flush them before exiting.
+		blogViewService.Close()
+		trashService.Close()
+	}, nil
+}
+
+// newApp registers the middleware and routes. It only takes the handler
+// methods, so the routes command can build it from nil handlers.
+func newApp(h *handlers) *fiber.App {
+	app := fiber.New(fiber.Config{
+		ErrorHandler: err.ErrorHandler,
+		BodyLimit:    config.BodyLimit(),
+	})
+
+	app.Use(logger.New())
+	app.Use(cors.New(cors.Config{
+		AllowOrigins: "*",
+		AllowMethods: "GET,POST,PUT,DELETE,OPTIONS",
+		AllowHeaders: "Origin, Content-Type, Accept",
+	}))
+	app.Use(compress.New(compress.Config{
+		Level: compress.LevelBestSpeed,
+	}))
+	app.Use(recover.New())
+	app.Use(middleware.LimitUploadSize())
+	app.Use(limiter.New(limiter.Config{
+		Max:        30,
+		Expiration: 10 * time.Second,
+		LimitReached: func(c *fiber.Ctx) error {
+			return fiber.NewError(fiber.StatusTooManyRequests, "Sorry, To Many Request. Please try again later.")
+		},
+	}))
+
+	app.Get("/swagger/*", swagger.HandlerDefault)
+
+	route := app.Group("/api/v1")
+
+	route.Get("/", RootHandler)
+	route.Get("/metrics", monitor.New(monitor.Config{Title: "Fiber Metrics Page"}))
+
+	// Init Router
+	router.UserRouter(route, h.user)
+	// Feeds must be registered before /blog/:id would capture them.
+	router.FeedRouter(route, h.feed)
+	router.BlogRouter(route, h.blog)
+	router.FileRouter(route, h.file)
+	router.TagRouter(route, h.tag)
+	router.CategoryRouter(route, h.category)
+	router.CommentRouter(route, h.comment)
+	router.ReactionRouter(route, h.reaction)
+	router.BlogRevisionRouter(route, h.blogRevision)
+	router.FollowRouter(route, h.follow)
+	router.BookmarkRouter(route, h.bookmark)
+	router.BlogViewRouter(route, h.blogView)
+	router.ModerationRouter(route, h.moderation)
+	router.ImportRouter(route, h.importer)
+	router.SeriesRouter(route, h.series)
+	router.ContributorRouter(route, h.contributor)
+	router.BlogTranslationRouter(route, h.blogTranslation)
+	router.TrashRouter(route, h.trash)
+
+	return app
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"learn/fiber/config"
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model/entity"
+	"learn/fiber/pkg/repository"
+	"learn/fiber/pkg/service"
+	"learn/fiber/utils"
+	"os"
+	"strings"
+)
+
+// runUser handles `user create-admin|reset-password` from the command line.
+func runUser(args []string) error {
+	if len(args) == 0 {
+		return usageError("user needs a command")
+	}
+
+	switch args[0] {
+	case "create-admin":
+		return runCreateAdmin(args[1:])
+	case "reset-password":
+		return runResetPassword(args[1:])
+	default:
+		return usageError(fmt.Sprintf("unknown user command %q", args[0]))
+	}
+}
+
+func runCreateAdmin(args []string) error {
+	flags := flag.NewFlagSet("user create-admin", flag.ContinueOnError)
+	email := flags.String("email", "", "email of the admin, required")
+	username := flags.String("username", "", "username of the admin, required")
+	password := flags.String("password", "", "password of the admin, read from stdin when empty")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	payload := entity.UserRegisterRequest{
+		Email:    strings.TrimSpace(*email),
+		Username: strings.TrimSpace(*username),
+		Role:     enum.ROLE_ADMIN,
+	}
+
+	secret, err := readPassword(*password)
+
+	if err != nil {
+		return err
+	}
+
+	payload.Password = secret
+	payload.ConfirmPassword = secret
+
+	if err := utils.ValidateStruct(utils.NewValidator(), &payload); err != nil {
+		return err
+	}
+
+	if !utils.ValidatePassword(payload.Password) {
+		return errors.New(utils.PasswordRules)
+	}
+
+	db := config.DBConfig()
+	userRepository := repository.NewUserRepository(db)
+
+	if _, err := userRepository.FindByEmail(payload.Email); err == nil {
+		return fmt.Errorf("a user with email %s already exists, use user reset-password to change its password", payload.Email)
+	}
+
+	userService := service.NewUserService(userRepository, repository.NewFollowRepository(db))
+	user, err := userService.RegisterUser(&payload)
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created admin %s (%s)\n", user.Email, user.Id)
+
+	return nil
+}
+
+// runResetPassword also clears PasswordResetRequired, it is how operators
+// unlock accounts created by an import.
+func runResetPassword(args []string) error {
+	flags := flag.NewFlagSet("user reset-password", flag.ContinueOnError)
+	email := flags.String("email", "", "email of the user, required")
+	password := flags.String("password", "", "new password, read from stdin when empty")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(*email) == "" {
+		return usageError("user reset-password needs -email")
+	}
+
+	secret, err := readPassword(*password)
+
+	if err != nil {
+		return err
+	}
+
+	if !utils.ValidatePassword(secret) {
+		return errors.New(utils.PasswordRules)
+	}
+
+	db := config.DBConfig()
+	userService := service.NewUserService(repository.NewUserRepository(db), repository.NewFollowRepository(db))
+
+	if err := userService.ResetPassword(strings.TrimSpace(*email), secret); err != nil {
+		return err
+	}
+
+	fmt.Printf("Reset the password of %s\n", strings.TrimSpace(*email))
+
+	return nil
+}
+
+// readPassword returns the flag value, or the first line of stdin so the
+// password stays out of the shell history and the process list.
+func readPassword(value string) (string, error) {
+	if value != "" {
+		return value, nil
+	}
+
+	if info, err := os.Stdin.Stat(); err == nil && info.Mode()&os.ModeCharDevice != 0 {
+		fmt.Fprint(os.Stderr, "Password: ")
+	}
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+
+	if err != nil && line == "" {
+		return "", errors.New("no password given, pass -password or write it to stdin")
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
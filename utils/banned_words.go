@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"learn/fiber/config"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// bannedWordsPattern is compiled once from MODERATION_BANNED_WORDS, it is
+// nil when no words are configured.
+var bannedWordsPattern = sync.OnceValue(func() *regexp.Regexp {
+	words := make([]string, 0)
+
+	for _, word := range strings.Split(config.MODERATION_BANNED_WORDS.GetValue(), ",") {
+		if word = strings.TrimSpace(word); word != "" {
+			words = append(words, regexp.QuoteMeta(word))
+		}
+	}
+
+	if len(words) == 0 {
+		return nil
+	}
+
+	return regexp.MustCompile(`(?i)\b(?:` + strings.Join(words, "|") + `)\b`)
+})
+
+// FindBannedWord returns the first banned word in text, matching whole words
+// ignoring case so "class" does not match a banned "ass".
+func FindBannedWord(text string) (string, bool) {
+	pattern := bannedWordsPattern()
+
+	if pattern == nil {
+		return "", false
+	}
+
+	word := pattern.FindString(text)
+
+	return word, word != ""
+}
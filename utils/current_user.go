@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"learn/fiber/pkg/model"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// payloadKey is the Locals key the JWT middlewares store the token payload
+// under.
+const payloadKey = "payload"
+
+func SetPayload(c *fiber.Ctx, payload model.JwtPayload) {
+	c.Locals(payloadKey, payload)
+}
+
+// GetPayload returns the payload of the authenticated user, ok is false for
+// anonymous requests.
+func GetPayload(c *fiber.Ctx) (payload model.JwtPayload, ok bool) {
+	payload, ok = c.Locals(payloadKey).(model.JwtPayload)
+
+	return payload, ok
+}
+
+// CurrentUser is GetPayload for routes that require authentication, it fails
+// with 401 instead of panicking when the route is missing JWTMidleware.
+func CurrentUser(c *fiber.Ctx) (model.JwtPayload, error) {
+	payload, ok := GetPayload(c)
+
+	if !ok {
+		return model.JwtPayload{}, fiber.NewError(fiber.StatusUnauthorized, "Unauthorized, no token provided")
+	}
+
+	return payload, nil
+}
+
+// ViewerId returns the id of the authenticated user on routes guarded by
+// OptionalJWT, or an empty string for anonymous requests.
+func ViewerId(c *fiber.Ctx) string {
+	payload, _ := GetPayload(c)
+
+	return payload.Id
+}
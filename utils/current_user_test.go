@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"errors"
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// withCtx runs check inside a request, after storing payload in its Locals
+// when one is given and the value of raw otherwise. The handler runs on
+// another goroutine, so check must report with t.Errorf only.
+func withCtx(t *testing.T, payload *model.JwtPayload, raw any, check func(c *fiber.Ctx)) {
+	t.Helper()
+
+	called := false
+	app := fiber.New()
+	app.Get("/", func(c *fiber.Ctx) error {
+		if payload != nil {
+			SetPayload(c, *payload)
+		} else if raw != nil {
+			c.Locals(payloadKey, raw)
+		}
+
+		check(c)
+		called = true
+
+		return nil
+	})
+
+	if _, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil)); err != nil {
+		t.Fatalf("app.Test: %v", err)
+	}
+
+	if !called {
+		t.Fatal("handler was not called")
+	}
+}
+
+func TestCurrentUser(t *testing.T) {
+	user := model.JwtPayload{Id: "usr_1", Role: enum.ROLE_ADMIN}
+
+	tests := []struct {
+		name    string
+		payload *model.JwtPayload
+		raw     any
+		want    model.JwtPayload
+		wantErr bool
+	}{
+		{"authenticated", &user, nil, user, false},
+		{"anonymous", nil, nil, model.JwtPayload{}, true},
+		{"payload of another type", nil, "usr_1", model.JwtPayload{}, true},
+		{"payload pointer", nil, &user, model.JwtPayload{}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			withCtx(t, test.payload, test.raw, func(c *fiber.Ctx) {
+				got, err := CurrentUser(c)
+
+				if (err != nil) != test.wantErr {
+					t.Errorf("CurrentUser error = %v, wantErr %v", err, test.wantErr)
+				}
+
+				var fiberErr *fiber.Error
+
+				if err != nil && (!errors.As(err, &fiberErr) || fiberErr.Code != fiber.StatusUnauthorized) {
+					t.Errorf("CurrentUser error = %v, want a 401", err)
+				}
+
+				if got != test.want {
+					t.Errorf("CurrentUser = %+v, want %+v", got, test.want)
+				}
+			})
+		})
+	}
+}
+
+func TestViewerId(t *testing.T) {
+	user := model.JwtPayload{Id: "usr_1", Role: enum.ROLE_USER}
+
+	tests := []struct {
+		name    string
+		payload *model.JwtPayload
+		raw     any
+		want    string
+	}{
+		{"authenticated", &user, nil, "usr_1"},
+		{"anonymous", nil, nil, ""},
+		{"payload of another type", nil, "usr_1", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			withCtx(t, test.payload, test.raw, func(c *fiber.Ctx) {
+				if got := ViewerId(c); got != test.want {
+					t.Errorf("ViewerId = %q, want %q", got, test.want)
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"learn/fiber/config"
+	"learn/fiber/pkg/model"
+	"strings"
+	"time"
+)
+
+// Cursor points at a row in a list ordered by (created_at, id) descending.
+// Backward cursors fetch the rows before it instead of after it.
+type Cursor struct {
+	CreatedAt time.Time `json:"t"`
+	Id        string    `json:"i"`
+	Backward  bool      `json:"b,omitempty"`
+}
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// EncodeCursor serializes the cursor and signs it, so clients can not craft
+// cursors that skip filters or probe arbitrary rows.
+func EncodeCursor(cursor Cursor) (string, error) {
+	payload, err := json.Marshal(cursor)
+
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(signCursor(encoded)), nil
+}
+
+func DecodeCursor(value string) (*Cursor, error) {
+	encoded, signature, found := strings.Cut(value, ".")
+
+	if !found {
+		return nil, ErrInvalidCursor
+	}
+
+	expected, err := base64.RawURLEncoding.DecodeString(signature)
+
+	if err != nil || !hmac.Equal(expected, signCursor(encoded)) {
+		return nil, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor Cursor
+
+	if err := json.Unmarshal(payload, &cursor); err != nil || cursor.Id == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+// NewMetaCursor builds the cursors around a page whose first and last rows
+// are first and last, hasMore tells whether the query found rows beyond the
+// page in the direction it was reading.
+func NewMetaCursor(limit int, current *Cursor, hasMore bool, first, last *Cursor) (*model.MetaCursor, error) {
+	meta := &model.MetaCursor{Limit: limit}
+
+	switch {
+	case current == nil:
+		meta.HasNext = hasMore
+	case current.Backward:
+		meta.HasNext = true
+		meta.HasPrev = hasMore
+	default:
+		meta.HasNext = hasMore
+		meta.HasPrev = true
+	}
+
+	if first == nil || last == nil {
+		return meta, nil
+	}
+
+	if meta.HasNext {
+		next, err := EncodeCursor(Cursor{CreatedAt: last.CreatedAt, Id: last.Id})
+
+		if err != nil {
+			return nil, err
+		}
+
+		meta.NextCursor = &next
+	}
+
+	if meta.HasPrev {
+		prev, err := EncodeCursor(Cursor{CreatedAt: first.CreatedAt, Id: first.Id, Backward: true})
+
+		if err != nil {
+			return nil, err
+		}
+
+		meta.PrevCursor = &prev
+	}
+
+	return meta, nil
+}
+
+func signCursor(encoded string) []byte {
+	secret := config.CURSOR_SECRET.GetValue()
+
+	if secret == "" {
+		secret = config.JWT_SECRET_ACCESS_TOKEN.GetValue()
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(encoded))
+
+	return mac.Sum(nil)
+}
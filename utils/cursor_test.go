@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	t.Setenv("CURSOR_SECRET", "cursor-secret")
+
+	createdAt := time.Date(2026, 10, 19, 8, 30, 15, 123456789, time.FixedZone("WIB", 7*60*60))
+
+	tests := []struct {
+		name   string
+		cursor Cursor
+	}{
+		{"forward", Cursor{CreatedAt: createdAt, Id: "blog_1"}},
+		{"backward", Cursor{CreatedAt: createdAt, Id: "blog_2", Backward: true}},
+		{"utc", Cursor{CreatedAt: createdAt.UTC(), Id: "blog_3"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			encoded, err := EncodeCursor(test.cursor)
+
+			if err != nil {
+				t.Fatalf("EncodeCursor: %v", err)
+			}
+
+			decoded, err := DecodeCursor(encoded)
+
+			if err != nil {
+				t.Fatalf("DecodeCursor(%q): %v", encoded, err)
+			}
+
+			if !decoded.CreatedAt.Equal(test.cursor.CreatedAt) || decoded.CreatedAt.Nanosecond() != test.cursor.CreatedAt.Nanosecond() {
+				t.Errorf("CreatedAt = %v, want %v", decoded.CreatedAt, test.cursor.CreatedAt)
+			}
+
+			if decoded.Id != test.cursor.Id || decoded.Backward != test.cursor.Backward {
+				t.Errorf("decoded %+v, want %+v", *decoded, test.cursor)
+			}
+		})
+	}
+}
+
+func TestDecodeCursorRejectsInvalid(t *testing.T) {
+	t.Setenv("CURSOR_SECRET", "cursor-secret")
+
+	valid, err := EncodeCursor(Cursor{CreatedAt: time.Now(), Id: "blog_1"})
+
+	if err != nil {
+		t.Fatalf("EncodeCursor: %v", err)
+	}
+
+	payload, signature, _ := strings.Cut(valid, ".")
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"t":"2026-01-01T00:00:00Z","i":"blog_2"}`))
+
+	// A cursor signed with another secret must not be accepted either.
+	t.Setenv("CURSOR_SECRET", "other-secret")
+	otherSecret, _ := EncodeCursor(Cursor{CreatedAt: time.Now(), Id: "blog_1"})
+	t.Setenv("CURSOR_SECRET", "cursor-secret")
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"empty", ""},
+		{"no signature", payload},
+		{"empty signature", payload + "."},
+		{"truncated signature", payload + "." + signature[:len(signature)-2]},
+		{"tampered signature", payload + "." + strings.Repeat("A", len(signature))},
+		{"signature not base64", payload + ".!!!"},
+		{"forged payload", forged + "." + signature},
+		{"truncated payload", payload[:len(payload)-2] + "." + signature},
+		{"extra field", valid + ".extra"},
+		{"other secret", otherSecret},
+		{"payload not base64", signRaw("!!!")},
+		{"payload not json", signJson("not json")},
+		{"missing id", signJson(`{"t":"2026-01-01T00:00:00Z"}`)},
+		{"invalid time", signJson(`{"t":"yesterday","i":"blog_1"}`)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := DecodeCursor(test.value); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("DecodeCursor(%q) error = %v, want ErrInvalidCursor", test.value, err)
+			}
+		})
+	}
+}
+
+// signJson builds a correctly signed cursor around an arbitrary payload, so
+// only the payload checks can reject it.
+func signJson(payload string) string {
+	return signRaw(base64.RawURLEncoding.EncodeToString([]byte(payload)))
+}
+
+// signRaw signs encoded as is, without base64 encoding it first.
+func signRaw(encoded string) string {
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(signCursor(encoded))
+}
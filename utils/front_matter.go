@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+
+	"gopkg.in/yaml.v3"
+)
+
+const frontMatterDelimiter = "---"
+
+// WriteFrontMatter renders meta as a YAML front matter block followed by the
+// body, the layout static site generators such as Hugo and Jekyll read.
+func WriteFrontMatter(meta any, body string) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	buffer.WriteString(frontMatterDelimiter + "\n")
+
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(meta); err != nil {
+		return nil, err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	buffer.WriteString(frontMatterDelimiter + "\n\n")
+	buffer.WriteString(body)
+
+	if len(body) > 0 && body[len(body)-1] != '\n' {
+		buffer.WriteByte('\n')
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// ParseFrontMatter decodes the YAML front matter of data into meta and
+// returns the body below it.
+func ParseFrontMatter(data []byte, meta any) (string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+
+	if !bytes.HasPrefix(data, []byte(frontMatterDelimiter+"\n")) {
+		return "", errors.New("missing front matter")
+	}
+
+	// rest starts at the newline ending the opening delimiter, so an empty
+	// front matter is found too.
+	rest := data[len(frontMatterDelimiter):]
+	closing := []byte("\n" + frontMatterDelimiter + "\n")
+	end := bytes.Index(rest, closing)
+	body := []byte{}
+
+	switch {
+	case end >= 0:
+		body = rest[end+len(closing):]
+	case bytes.HasSuffix(rest, closing[:len(closing)-1]):
+		end = len(rest) - len(closing) + 1
+	default:
+		return "", errors.New("front matter is not closed")
+	}
+
+	if err := yaml.Unmarshal(rest[:end], meta); err != nil {
+		return "", err
+	}
+
+	return string(bytes.TrimLeft(body, "\n")), nil
+}
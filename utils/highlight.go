@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"html"
+	"strings"
+)
+
+// HighlightStart and HighlightStop delimit the search matches in a raw
+// headline. They are control characters, so they survive HTML escaping and
+// never collide with the markup of a blog.
+const (
+	HighlightStart = "\x01"
+	HighlightStop  = "\x02"
+)
+
+// HighlightMatches escapes a headline as plain text and only then wraps the
+// delimited matches in <mark>, a blog can not inject markup through its
+// title or body. Unbalanced delimiters are dropped.
+func HighlightMatches(headline string) string {
+	var builder strings.Builder
+	open := false
+
+	for _, part := range strings.SplitAfter(html.EscapeString(headline), HighlightStop) {
+		text, closed := strings.CutSuffix(part, HighlightStop)
+		before, match, found := strings.Cut(text, HighlightStart)
+
+		builder.WriteString(strings.ReplaceAll(before, HighlightStart, ""))
+
+		if found && !open {
+			builder.WriteString("<mark>")
+			open = true
+		}
+
+		builder.WriteString(strings.ReplaceAll(match, HighlightStart, ""))
+
+		if closed && open {
+			builder.WriteString("</mark>")
+			open = false
+		}
+	}
+
+	if open {
+		builder.WriteString("</mark>")
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"learn/fiber/pkg/enum"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PreferredLocales lists the supported locales a reader asked for, most
+// preferred first. An explicit lang wins over the Accept-Language header,
+// whose entries are ordered by their q value. Region subtags are ignored, so
+// id-ID and en-US match id and en.
+func PreferredLocales(lang, acceptLanguage string) []enum.ELocale {
+	type weighted struct {
+		locale  enum.ELocale
+		quality float64
+	}
+
+	candidates := []weighted{}
+
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+
+			if err != nil {
+				continue
+			}
+
+			quality = parsed
+		}
+
+		if locale, ok := ParseLocale(tag); ok && quality > 0 {
+			candidates = append(candidates, weighted{locale, quality})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+
+	locales := []enum.ELocale{}
+
+	if locale, ok := ParseLocale(lang); ok {
+		locales = append(locales, locale)
+	}
+
+	for _, candidate := range candidates {
+		if !slices.Contains(locales, candidate.locale) {
+			locales = append(locales, candidate.locale)
+		}
+	}
+
+	return locales
+}
+
+// ParseLocale accepts a supported locale with or without a region, "in" is
+// the former code of Indonesian that some browsers still send.
+func ParseLocale(tag string) (enum.ELocale, bool) {
+	primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+	primary, _, _ = strings.Cut(primary, "_")
+
+	if primary == "in" {
+		primary = string(enum.LOCALE_INDONESIAN)
+	}
+
+	locale := enum.ELocale(primary)
+
+	return locale, slices.Contains(enum.Locales, locale)
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"fmt"
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model"
+	"regexp"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+var filterKeyPattern = regexp.MustCompile(`^filter\[([^\[\]]+)\](?:\[([^\[\]]+)\])?$`)
+
+// ParseFilters collects the filter[field] and filter[field][operator] query
+// parameters, whether the field and operator are allowed is left to the
+// repository that owns the columns.
+func ParseFilters(c *fiber.Ctx) (model.Filters, error) {
+	filters := model.Filters{}
+	var err error
+
+	c.Context().QueryArgs().VisitAll(func(key, value []byte) {
+		name := string(key)
+
+		if err != nil || !strings.HasPrefix(name, "filter[") {
+			return
+		}
+
+		match := filterKeyPattern.FindStringSubmatch(name)
+
+		if match == nil {
+			err = fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("%s: expected filter[field] or filter[field][operator]", name))
+			return
+		}
+
+		operator := enum.FILTER_EQ
+
+		if match[2] != "" {
+			operator = enum.EFilterOperator(match[2])
+		}
+
+		if filters[match[1]] == nil {
+			filters[match[1]] = map[enum.EFilterOperator]string{}
+		}
+
+		filters[match[1]][operator] = string(value)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return filters, nil
+}
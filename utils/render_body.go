@@ -0,0 +1,206 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"learn/fiber/pkg/enum"
+	"learn/fiber/pkg/model/res"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+	nethtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// wordsPerMinute is the average adult silent reading speed used for the
+// reading time estimate.
+const wordsPerMinute = 200
+
+// RenderedBody is a blog body turned into HTML that is safe to embed as is.
+type RenderedBody struct {
+	Html        string
+	Toc         []res.TocEntry
+	WordCount   int
+	ReadingTime int
+}
+
+// Raw HTML is let through goldmark on purpose, every format ends up in the
+// same sanitizer so it is the only place deciding what markup is allowed.
+var markdown = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+	goldmark.WithRendererOptions(goldmarkhtml.WithUnsafe()),
+)
+
+var bodyPolicy = newBodyPolicy()
+
+var blankLines = regexp.MustCompile(`\n\s*\n`)
+
+func newBodyPolicy() *bluemonday.Policy {
+	policy := bluemonday.UGCPolicy()
+
+	// Syntax highlighting hooks for fenced code blocks.
+	policy.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+#-]+$`)).OnElements("code")
+
+	// GFM task list items are rendered as disabled checkboxes.
+	policy.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	policy.AllowAttrs("checked", "disabled").OnElements("input")
+
+	policy.RequireNoReferrerOnLinks(true)
+	policy.AddTargetBlankToFullyQualifiedLinks(true)
+
+	return policy
+}
+
+// RenderBody renders body according to its format, sanitizes the result and
+// gives every heading a stable id so the table of contents can link to it.
+func RenderBody(body string, format enum.EBlogFormat) (*RenderedBody, error) {
+	var unsafe string
+
+	switch format {
+	case enum.BLOG_FORMAT_MARKDOWN:
+		var buffer bytes.Buffer
+
+		if err := markdown.Convert([]byte(body), &buffer); err != nil {
+			return nil, err
+		}
+
+		unsafe = buffer.String()
+	case enum.BLOG_FORMAT_HTML:
+		unsafe = body
+	default:
+		unsafe = plainToHtml(body)
+	}
+
+	nodes, err := nethtml.ParseFragment(
+		strings.NewReader(bodyPolicy.Sanitize(unsafe)),
+		&nethtml.Node{Type: nethtml.ElementNode, Data: "div", DataAtom: atom.Div},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rendered := &RenderedBody{Toc: []res.TocEntry{}}
+	ids := map[string]int{}
+	var text strings.Builder
+	var output bytes.Buffer
+
+	for _, node := range nodes {
+		walkBody(node, rendered, ids, &text)
+
+		if err := nethtml.Render(&output, node); err != nil {
+			return nil, err
+		}
+	}
+
+	rendered.Html = output.String()
+	rendered.WordCount = len(strings.Fields(text.String()))
+	rendered.ReadingTime = int(math.Ceil(float64(rendered.WordCount) / wordsPerMinute))
+
+	return rendered, nil
+}
+
+// plainToHtml keeps the paragraphs and line breaks of plain text.
+func plainToHtml(body string) string {
+	var builder strings.Builder
+
+	for _, paragraph := range blankLines.Split(strings.ReplaceAll(body, "\r\n", "\n"), -1) {
+		paragraph = strings.TrimSpace(paragraph)
+
+		if paragraph == "" {
+			continue
+		}
+
+		builder.WriteString("<p>")
+		builder.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>"))
+		builder.WriteString("</p>\n")
+	}
+
+	return builder.String()
+}
+
+func walkBody(node *nethtml.Node, rendered *RenderedBody, ids map[string]int, text *strings.Builder) {
+	if node.Type == nethtml.TextNode {
+		text.WriteString(node.Data)
+		text.WriteByte(' ')
+		return
+	}
+
+	if level := headingLevel(node.DataAtom); level > 0 {
+		title := strings.Join(strings.Fields(nodeText(node)), " ")
+		id := headingId(node, title, ids)
+
+		rendered.Toc = append(rendered.Toc, res.TocEntry{Level: level, Id: id, Title: title})
+	}
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		walkBody(child, rendered, ids, text)
+	}
+}
+
+func headingLevel(tag atom.Atom) int {
+	switch tag {
+	case atom.H1:
+		return 1
+	case atom.H2:
+		return 2
+	case atom.H3:
+		return 3
+	case atom.H4:
+		return 4
+	case atom.H5:
+		return 5
+	case atom.H6:
+		return 6
+	}
+
+	return 0
+}
+
+// headingId keeps an id the author already set and otherwise derives one from
+// the title, repeated titles get a numeric suffix like GitHub does.
+func headingId(node *nethtml.Node, title string, ids map[string]int) string {
+	for _, attr := range node.Attr {
+		if attr.Key == "id" && attr.Val != "" {
+			ids[attr.Val]++
+			return attr.Val
+		}
+	}
+
+	base := Slugify(title)
+
+	if base == "" {
+		base = "section"
+	}
+
+	id := base
+
+	for suffix := 1; ids[id] > 0; suffix++ {
+		id = fmt.Sprintf("%s-%d", base, suffix)
+	}
+
+	ids[id]++
+	node.Attr = append(node.Attr, nethtml.Attribute{Key: "id", Val: id})
+
+	return id
+}
+
+func nodeText(node *nethtml.Node) string {
+	if node.Type == nethtml.TextNode {
+		return node.Data
+	}
+
+	var builder strings.Builder
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		builder.WriteString(nodeText(child))
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"learn/fiber/pkg/enum"
+	"strings"
+	"testing"
+
+	nethtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+func TestRenderBodyStripsUnsafeMarkup(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		format enum.EBlogFormat
+	}{
+		{"markdown script", "Hello\n\n<script>alert(1)</script>", enum.BLOG_FORMAT_MARKDOWN},
+		{"markdown event handler", `<img src="/a.png" onerror="alert(1)">`, enum.BLOG_FORMAT_MARKDOWN},
+		{"markdown javascript link", "[click](javascript:alert(1))", enum.BLOG_FORMAT_MARKDOWN},
+		{"markdown raw javascript link", `<a href="javascript:alert(1)">click</a>`, enum.BLOG_FORMAT_MARKDOWN},
+		{"html script", "<p>Hello</p><script>alert(1)</script>", enum.BLOG_FORMAT_HTML},
+		{"html event handlers", `<p onclick="alert(1)">Hello</p><img src="/a.png" onerror="alert(1)">`, enum.BLOG_FORMAT_HTML},
+		{"html javascript link", `<a href="JaVaScRiPt:alert(1)">click</a>`, enum.BLOG_FORMAT_HTML},
+		{"html encoded javascript link", `<a href="&#106;avascript:alert(1)">click</a>`, enum.BLOG_FORMAT_HTML},
+		{"html iframe and style", `<iframe src="https://example.com"></iframe><style>p{}</style>`, enum.BLOG_FORMAT_HTML},
+		{"plain script", "Hello\n\n<script>alert(1)</script>", enum.BLOG_FORMAT_PLAIN},
+		{"plain event handler", `<img src="/a.png" onerror="alert(1)">`, enum.BLOG_FORMAT_PLAIN},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rendered, err := RenderBody(test.body, test.format)
+
+			if err != nil {
+				t.Fatalf("RenderBody: %v", err)
+			}
+
+			assertSafeHtml(t, rendered.Html)
+		})
+	}
+}
+
+func TestRenderBodyKeepsFormatting(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		format enum.EBlogFormat
+		want   []string
+	}{
+		{
+			name:   "markdown",
+			body:   "## Intro\n\n**bold** _em_ `code` [link](https://example.com)\n\n```go\nx := 1\n```\n\n- [x] done\n\n> quote",
+			format: enum.BLOG_FORMAT_MARKDOWN,
+			want: []string{
+				`<h2 id="intro">Intro</h2>`,
+				"<strong>bold</strong>",
+				"<em>em</em>",
+				"<code>code</code>",
+				`<a href="https://example.com" rel="nofollow noreferrer noopener" target="_blank">link</a>`,
+				`<code class="language-go">`,
+				`<input checked="" disabled="" type="checkbox"/>`,
+				"<blockquote>",
+			},
+		},
+		{
+			name:   "html",
+			body:   `<h2>Intro</h2><p><strong>bold</strong> <em>em</em> <a href="/blog/1">link</a></p><ul><li>item</li></ul><img src="/a.png" alt="a">`,
+			format: enum.BLOG_FORMAT_HTML,
+			want: []string{
+				`<h2 id="intro">Intro</h2>`,
+				"<strong>bold</strong>",
+				"<em>em</em>",
+				`<a href="/blog/1" rel="nofollow noreferrer">link</a>`,
+				"<ul><li>item</li></ul>",
+				`<img src="/a.png" alt="a"/>`,
+			},
+		},
+		{
+			name:   "plain",
+			body:   "line one\nline <b>two</b>\n\nsecond paragraph",
+			format: enum.BLOG_FORMAT_PLAIN,
+			want: []string{
+				"<p>line one<br/>line &lt;b&gt;two&lt;/b&gt;</p>",
+				"<p>second paragraph</p>",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rendered, err := RenderBody(test.body, test.format)
+
+			if err != nil {
+				t.Fatalf("RenderBody: %v", err)
+			}
+
+			for _, want := range test.want {
+				if !strings.Contains(rendered.Html, want) {
+					t.Errorf("rendered HTML is missing %s\n%s", want, rendered.Html)
+				}
+			}
+		})
+	}
+}
+
+// assertSafeHtml fails when the rendered HTML contains an element that runs
+// scripts, an event handler attribute or a javascript: URL.
+func assertSafeHtml(t *testing.T, rendered string) {
+	t.Helper()
+
+	nodes, err := nethtml.ParseFragment(
+		strings.NewReader(rendered),
+		&nethtml.Node{Type: nethtml.ElementNode, Data: "div", DataAtom: atom.Div},
+	)
+
+	if err != nil {
+		t.Fatalf("parse rendered HTML: %v", err)
+	}
+
+	var walk func(node *nethtml.Node)
+
+	walk = func(node *nethtml.Node) {
+		if node.Type == nethtml.ElementNode {
+			switch node.DataAtom {
+			case atom.Script, atom.Iframe, atom.Style, atom.Object, atom.Embed:
+				t.Errorf("rendered HTML contains <%s>: %s", node.Data, rendered)
+			}
+
+			for _, attr := range node.Attr {
+				if strings.HasPrefix(strings.ToLower(attr.Key), "on") {
+					t.Errorf("rendered HTML contains the %s attribute: %s", attr.Key, rendered)
+				}
+
+				if strings.HasPrefix(strings.ToLower(strings.TrimSpace(attr.Val)), "javascript:") {
+					t.Errorf("rendered HTML contains a javascript: URL: %s", rendered)
+				}
+			}
+		}
+
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+
+	for _, node := range nodes {
+		walk(node)
+	}
+}
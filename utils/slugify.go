@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+func Slugify(value string) string {
+	var builder strings.Builder
+	lastDash := false
+
+	for _, char := range strings.ToLower(strings.TrimSpace(value)) {
+		switch {
+		case unicode.IsLetter(char) || unicode.IsNumber(char):
+			builder.WriteRune(char)
+			lastDash = false
+		case !lastDash && builder.Len() > 0:
+			builder.WriteRune('-')
+			lastDash = true
+		}
+	}
+
+	return strings.TrimSuffix(builder.String(), "-")
+}
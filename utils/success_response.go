@@ -22,3 +22,12 @@ func SuccessResponsePaginate[T any](c *fiber.Ctx, code int, message string, data
 		Meta:    meta,
 	})
 }
+
+func SuccessResponseCursor[T any](c *fiber.Ctx, code int, message string, data T, meta *model.MetaCursor) error {
+	return c.Status(code).JSON(model.ResponseEntityCursor[T]{
+		Code:    code,
+		Message: message,
+		Data:    data,
+		Meta:    meta,
+	})
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"learn/fiber/config"
@@ -67,12 +68,37 @@ func ValidateToken(token string, secret string) (model.JwtPayload, error) {
 		return model.JwtPayload{}, errors.New("invalid token")
 	}
 
+	id, idOk := claims["id"].(string)
+	role, roleOk := claims["role"].(string)
+
+	if !idOk || !roleOk || id == "" {
+		return model.JwtPayload{}, errors.New("invalid token claims")
+	}
+
 	return model.JwtPayload{
-		Id:   claims["id"].(string),
-		Role: enum.ERole(claims["role"].(string)),
+		Id:   id,
+		Role: enum.ERole(role),
 	}, nil
 }
 
+// ParseBearerToken extracts the token from an Authorization header of the
+// form "Bearer <token>", the scheme is matched case-insensitively.
+func ParseBearerToken(header string) (string, error) {
+	scheme, token, found := strings.Cut(strings.TrimSpace(header), " ")
+
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", errors.New("authorization header must use the Bearer scheme")
+	}
+
+	token = strings.TrimSpace(token)
+
+	if token == "" || strings.ContainsAny(token, " \t") {
+		return "", errors.New("malformed bearer token")
+	}
+
+	return token, nil
+}
+
 func generateToken(jwtPayload model.JwtPayload, secret string, expTime time.Duration) (string, error) {
 	claims := jwt.MapClaims{
 		"id":   jwtPayload.Id,
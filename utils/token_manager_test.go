@@ -0,0 +1,41 @@
+package utils
+
+import "testing"
+
+func TestParseBearerToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		token   string
+		wantErr bool
+	}{
+		{"bearer", "Bearer abc.def.ghi", "abc.def.ghi", false},
+		{"lower case scheme", "bearer abc.def.ghi", "abc.def.ghi", false},
+		{"upper case scheme", "BEARER abc.def.ghi", "abc.def.ghi", false},
+		{"surrounding spaces", "  Bearer abc.def.ghi  ", "abc.def.ghi", false},
+		{"spaces before token", "Bearer    abc.def.ghi", "abc.def.ghi", false},
+		{"empty header", "", "", true},
+		{"scheme only", "Bearer", "", true},
+		{"scheme and space only", "Bearer   ", "", true},
+		{"token only", "abc.def.ghi", "", true},
+		{"other scheme", "Basic dXNlcjpwYXNz", "", true},
+		{"scheme without separator", "Bearerabc.def.ghi", "", true},
+		{"tab separator", "Bearer\tabc.def.ghi", "", true},
+		{"space inside token", "Bearer abc def", "", true},
+		{"tab inside token", "Bearer abc\tdef", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			token, err := ParseBearerToken(test.header)
+
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ParseBearerToken(%q) error = %v, wantErr %v", test.header, err, test.wantErr)
+			}
+
+			if token != test.token {
+				t.Errorf("ParseBearerToken(%q) = %q, want %q", test.header, token, test.token)
+			}
+		})
+	}
+}
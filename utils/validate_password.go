@@ -2,6 +2,9 @@ package utils
 
 import "unicode"
 
+// PasswordRules describes what ValidatePassword accepts.
+const PasswordRules = "Password must be at least 6 characters long, contain at least one uppercase letter, one number, and one special character"
+
 func ValidatePassword(password string) bool {
 	if len(password) < 6 {
 		return false
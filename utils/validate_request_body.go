@@ -10,8 +10,24 @@ func ValidateRequestBody[T any](c *fiber.Ctx, validator *validator.Validate, pay
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
+	return ValidateStruct(validator, payload)
+}
+
+// Normalizer is implemented by payloads that clean up their fields, such as
+// trimming a title, so the rules apply to the value that gets stored.
+type Normalizer interface {
+	Normalize()
+}
+
+// ValidateStruct validates a payload that was not read from a request, such
+// as a command line fixture, and reports the same field errors.
+func ValidateStruct(validator *validator.Validate, payload any) error {
+	if normalizer, ok := payload.(Normalizer); ok {
+		normalizer.Normalize()
+	}
+
 	if err := validator.Struct(payload); err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		return toValidationError(err)
 	}
 
 	return nil
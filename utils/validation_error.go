@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"learn/fiber/pkg/model"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/go-playground/validator/v10/non-standard/validators"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ValidationError carries one message per invalid field, the error handler
+// returns them as the data of the error response.
+type ValidationError struct {
+	Code   int
+	Errors []model.FieldError
+}
+
+func NewValidationError(code int, errors ...model.FieldError) *ValidationError {
+	return &ValidationError{Code: code, Errors: errors}
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+
+	for _, fieldError := range e.Errors {
+		messages = append(messages, fieldError.Field+" "+fieldError.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// NewValidator reports fields by their json name and registers the
+// validations shared by every handler.
+func NewValidator() *validator.Validate {
+	validate := validator.New()
+
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+
+		switch name {
+		case "-":
+			return ""
+		case "":
+			return field.Name
+		}
+
+		return name
+	})
+
+	validate.RegisterValidation("notblank", validators.NotBlank)
+
+	return validate
+}
+
+// toValidationError turns validator errors into field errors and anything
+// else into a plain bad request.
+func toValidationError(err error) error {
+	var validationErrors validator.ValidationErrors
+
+	if !errors.As(err, &validationErrors) {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	fieldErrors := make([]model.FieldError, 0, len(validationErrors))
+
+	for _, fieldError := range validationErrors {
+		field := fieldError.Namespace()
+
+		if _, rest, found := strings.Cut(field, "."); found {
+			field = rest
+		}
+
+		fieldErrors = append(fieldErrors, model.FieldError{
+			Field:   field,
+			Message: fieldErrorMessage(fieldError),
+		})
+	}
+
+	return NewValidationError(fiber.StatusBadRequest, fieldErrors...)
+}
+
+func fieldErrorMessage(fieldError validator.FieldError) string {
+	unit := ""
+
+	switch fieldError.Kind() {
+	case reflect.String:
+		unit = " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		unit = " items"
+	}
+
+	switch fieldError.Tag() {
+	case "required", "notblank":
+		return "is required"
+	case "min":
+		return fmt.Sprintf("must be at least %s%s", fieldError.Param(), unit)
+	case "max":
+		return fmt.Sprintf("must be at most %s%s", fieldError.Param(), unit)
+	case "len":
+		return fmt.Sprintf("must be exactly %s%s", fieldError.Param(), unit)
+	case "oneof":
+		return "must be one of " + strings.ReplaceAll(fieldError.Param(), " ", ", ")
+	case "email":
+		return "must be a valid email address"
+	case "url":
+		return "must be a valid URL"
+	}
+
+	return fmt.Sprintf("failed the %s validation", fieldError.Tag())
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"learn/fiber/config"
+)
+
+// HashVisitor identifies a visitor without storing who they are, signed in
+// users are identified by their id and anonymous ones by IP and user agent.
+func HashVisitor(userId, ip, userAgent string) string {
+	secret := config.VIEW_HASH_SECRET.GetValue()
+
+	if secret == "" {
+		secret = config.JWT_SECRET_ACCESS_TOKEN.GetValue()
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+
+	if userId != "" {
+		mac.Write([]byte("user:" + userId))
+	} else {
+		mac.Write([]byte("anonymous:" + ip + "|" + userAgent))
+	}
+
+	return hex.EncodeToString(mac.Sum(nil))
+}